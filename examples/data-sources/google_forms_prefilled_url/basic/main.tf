@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    googleforms = {
+      source  = "45ck/googleforms"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "googleforms" {}
+
+data "googleforms_prefilled_url" "example" {
+  form_id = "form-id"
+
+  answers = {
+    team     = ["Platform"]
+    services = ["API", "Billing"]
+  }
+
+  grid_answers = {
+    satisfaction = {
+      "Docs"    = ["Good"]
+      "Support" = ["Excellent"]
+    }
+  }
+}
+
+output "prefilled_url" {
+  value = data.googleforms_prefilled_url.example.url
+}
@@ -0,0 +1,28 @@
+variable "template_form_id" {
+  type        = string
+  description = "Form whose theme, confirmation message and questions are copied."
+}
+
+# The form is created as a Drive copy of the template, so it keeps settings
+# that the Forms API cannot set. Copied questions with the same type and
+# title (or position) are adopted by the item blocks; other copied items
+# are deleted.
+resource "googleforms_form" "from_template" {
+  title          = "Event Registration 2026"
+  source_form_id = var.template_form_id
+
+  item {
+    item_key = "name"
+    short_answer {
+      question_text = "Your name"
+      required      = true
+    }
+  }
+
+  item {
+    item_key = "dietary"
+    paragraph {
+      question_text = "Dietary requirements"
+    }
+  }
+}
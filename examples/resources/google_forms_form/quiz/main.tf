@@ -35,12 +35,37 @@ resource "googleforms_form" "geography_quiz" {
   }
 
   item {
-    item_key = "q3_essay"
+    item_key = "q3_nordic"
+    checkbox {
+      question_text = "Which of these countries are Nordic?"
+      options       = ["Norway", "Austria", "Finland", "Portugal"]
+      grading {
+        points          = 4
+        correct_answers = ["Norway", "Finland"]
+
+        feedback_link {
+          applies_to   = "incorrect"
+          uri          = "https://en.wikipedia.org/wiki/Nordic_countries"
+          display_text = "Nordic countries"
+        }
+      }
+    }
+  }
+
+  item {
+    item_key = "q4_essay"
     paragraph {
       question_text = "Explain why tectonic plates move. (Manually graded)"
       required      = true
       grading {
-        points = 20
+        points           = 20
+        general_feedback = "Mention convection currents in the mantle."
+
+        feedback_video {
+          applies_to   = "general"
+          youtube_uri  = "https://www.youtube.com/watch?v=ryrXAGY1dmE"
+          display_text = "Plate tectonics explained"
+        }
       }
     }
   }
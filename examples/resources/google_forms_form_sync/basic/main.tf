@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    googleforms = {
+      source  = "45ck/googleforms"
+      version = "~> 0.1"
+    }
+  }
+}
+
+provider "googleforms" {}
+
+variable "staging_form_id" {
+  type        = string
+  description = "Form that is edited in the Google Forms UI."
+}
+
+variable "production_form_id" {
+  type        = string
+  description = "Form that collects production responses."
+}
+
+# Promote the questions of the staging form to the production form. Items
+# that already exist in production keep their IDs, so their responses stay
+# attached.
+resource "googleforms_form_sync" "promote" {
+  source_form_id = var.staging_form_id
+  target_form_id = var.production_form_id
+}
+
+output "item_id_map" {
+  value = googleforms_form_sync.promote.item_id_map
+}
@@ -52,6 +52,8 @@ provider "googleforms" {}
 
 resource "googleforms_drive_folder" "test" {
   name = "` + name + `"
+
+  deletion_protection = false
 }
 `
 }
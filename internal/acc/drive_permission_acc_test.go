@@ -53,6 +53,8 @@ provider "googleforms" {}
 
 resource "googleforms_spreadsheet" "test" {
   title = "` + title + `"
+
+  deletion_protection = false
 }
 
 resource "googleforms_drive_permission" "test" {
@@ -54,6 +54,8 @@ provider "googleforms" {}
 
 resource "googleforms_drive_folder" "test" {
   name = "` + folderName + `"
+
+  deletion_protection = false
 }
 
 resource "googleforms_form" "test" {
@@ -65,6 +67,8 @@ resource "googleforms_form" "test" {
   manage_mode     = "all"
   update_strategy = "replace_all"
 
+  deletion_protection = false
+
   item {
     item_key = "name"
     short_answer {
@@ -52,6 +52,8 @@ provider "googleforms" {}
 
 resource "googleforms_spreadsheet" "test" {
   title = "` + title + `"
+
+  deletion_protection = false
 }
 
 resource "googleforms_sheet_values" "test" {
@@ -15,23 +15,44 @@ import (
 	sheets "google.golang.org/api/sheets/v4"
 )
 
-func oauthScopes() []string {
-	return []string{
+// Options selects the optional OAuth scopes of a Client. They are off by
+// default: with domain-wide delegation, a token request for a scope the Admin
+// console does not allow fails.
+type Options struct {
+	// ReadResponses requests forms.responses.readonly to list form responses.
+	ReadResponses bool
+	// ReadDriveFiles requests drive.readonly, so that files the provider did
+	// not create can be copied. drive.file only covers the provider's own.
+	ReadDriveFiles bool
+}
+
+// oauthScopes returns the scopes to request.
+func oauthScopes(opts Options) []string {
+	scopes := []string{
 		forms.FormsBodyScope,
 		drive.DriveFileScope,
 		sheets.SpreadsheetsScope,
 	}
+	if opts.ReadResponses {
+		scopes = append(scopes, forms.FormsResponsesReadonlyScope)
+	}
+	if opts.ReadDriveFiles {
+		scopes = append(scopes, drive.DriveReadonlyScope)
+	}
+	return scopes
 }
 
 // NewClient creates a new Client with real Google API implementations.
 // credentials is the service account JSON content or empty for ADC.
 // impersonateUser is the email to impersonate via domain-wide delegation.
+// opts selects the optional scopes to request.
 func NewClient(
 	ctx context.Context,
 	credentials string,
 	impersonateUser string,
+	opts Options,
 ) (*Client, error) {
-	tokenSource, err := buildTokenSource(ctx, credentials, impersonateUser)
+	tokenSource, err := buildTokenSource(ctx, credentials, impersonateUser, opts)
 	if err != nil {
 		return nil, fmt.Errorf("building token source: %w", err)
 	}
@@ -57,6 +78,9 @@ func NewClient(
 		Forms:  NewFormsAPIClient(formsService, retryCfg),
 		Drive:  NewDriveAPIClient(driveService, retryCfg),
 		Sheets: NewSheetsAPIClient(sheetsService, retryCfg),
+
+		ReadResponses:  opts.ReadResponses,
+		ReadDriveFiles: opts.ReadDriveFiles,
 	}, nil
 }
 
@@ -67,12 +91,14 @@ func buildTokenSource(
 	ctx context.Context,
 	credentials string,
 	impersonateUser string,
+	opts Options,
 ) (oauth2.TokenSource, error) {
+	scopes := oauthScopes(opts)
 	if credentials != "" {
-		return tokenSourceFromJSON(ctx, []byte(credentials), impersonateUser)
+		return tokenSourceFromJSON(ctx, []byte(credentials), impersonateUser, scopes)
 	}
 
-	return tokenSourceFromADC(ctx)
+	return tokenSourceFromADC(ctx, scopes)
 }
 
 // tokenSourceFromJSON creates a token source from service account JSON.
@@ -80,8 +106,9 @@ func tokenSourceFromJSON(
 	ctx context.Context,
 	credJSON []byte,
 	impersonateUser string,
+	scopes []string,
 ) (oauth2.TokenSource, error) {
-	config, err := google.JWTConfigFromJSON(credJSON, oauthScopes()...)
+	config, err := google.JWTConfigFromJSON(credJSON, scopes...)
 	if err != nil {
 		return nil, fmt.Errorf("parsing service account credentials: %w", err)
 	}
@@ -94,8 +121,8 @@ func tokenSourceFromJSON(
 }
 
 // tokenSourceFromADC creates a token source from application default credentials.
-func tokenSourceFromADC(ctx context.Context) (oauth2.TokenSource, error) {
-	creds, err := google.FindDefaultCredentials(ctx, oauthScopes()...)
+func tokenSourceFromADC(ctx context.Context, scopes []string) (oauth2.TokenSource, error) {
+	creds, err := google.FindDefaultCredentials(ctx, scopes...)
 	if err != nil {
 		return nil, fmt.Errorf("finding default credentials: %w", err)
 	}
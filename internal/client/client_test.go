@@ -0,0 +1,52 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	drive "google.golang.org/api/drive/v3"
+	forms "google.golang.org/api/forms/v1"
+)
+
+func TestOAuthScopes_ResponsesScopeIsOptIn(t *testing.T) {
+	t.Parallel()
+
+	if slices.Contains(oauthScopes(Options{}), forms.FormsResponsesReadonlyScope) {
+		t.Error("responses scope requested without read_responses")
+	}
+	if !slices.Contains(oauthScopes(Options{ReadResponses: true}), forms.FormsResponsesReadonlyScope) {
+		t.Error("responses scope not requested with read_responses")
+	}
+}
+
+func TestOAuthScopes_DriveReadonlyIsOptIn(t *testing.T) {
+	t.Parallel()
+
+	if slices.Contains(oauthScopes(Options{}), drive.DriveReadonlyScope) {
+		t.Error("drive.readonly requested without read_drive_files")
+	}
+	if !slices.Contains(oauthScopes(Options{ReadDriveFiles: true}), drive.DriveReadonlyScope) {
+		t.Error("drive.readonly not requested with read_drive_files")
+	}
+}
+
+func TestCopySourceError(t *testing.T) {
+	t.Parallel()
+
+	notFound := &NotFoundError{Resource: "file", ID: "template"}
+	if err := (&Client{}).CopySourceError(notFound); !strings.Contains(err.Error(), "read_drive_files = true") || !IsNotFound(err) {
+		t.Errorf("without read_drive_files: %v", err)
+	}
+	if err := (&Client{ReadDriveFiles: true}).CopySourceError(notFound); strings.Contains(err.Error(), "read_drive_files") {
+		t.Errorf("with read_drive_files: %v", err)
+	}
+	other := errors.New("quota exceeded")
+	if err := (&Client{}).CopySourceError(other); err != other {
+		t.Errorf("other errors must be returned unchanged, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -53,6 +54,35 @@ func (c *DriveAPIClient) Delete(
 	return nil
 }
 
+// Trash moves a Drive file to the trash, from where it can be restored.
+func (c *DriveAPIClient) Trash(
+	ctx context.Context,
+	fileID string,
+	supportsAllDrives bool,
+) error {
+	err := WithRetry(ctx, c.retry, func() error {
+		_, apiErr := c.service.Files.Update(fileID, &drive.File{Trashed: true}).
+			Context(ctx).
+			SupportsAllDrives(supportsAllDrives).
+			Do()
+		if apiErr != nil {
+			return wrapDriveAPIError(apiErr, "trash file "+fileID)
+		}
+		return nil
+	})
+
+	if err != nil && IsNotFound(err) {
+		// File already deleted; treat as success.
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("drive.Trash: %w", err)
+	}
+
+	return nil
+}
+
 // GetParents returns the current parent folder IDs for a Drive file.
 func (c *DriveAPIClient) GetParents(
 	ctx context.Context,
@@ -275,6 +305,63 @@ func (c *DriveAPIClient) CreateFile(
 	return result, nil
 }
 
+// UploadFile creates a Drive file with content via a media upload.
+func (c *DriveAPIClient) UploadFile(
+	ctx context.Context,
+	f *drive.File,
+	contentType string,
+	content []byte,
+	supportsAllDrives bool,
+) (*drive.File, error) {
+	var result *drive.File
+
+	err := WithRetry(ctx, c.retry, func() error {
+		resp, apiErr := c.service.Files.Create(f).
+			Context(ctx).
+			Media(bytes.NewReader(content), googleapi.ContentType(contentType)).
+			SupportsAllDrives(supportsAllDrives).
+			Fields("id,name,mimeType,parents,webViewLink,trashed,createdTime").
+			Do()
+		if apiErr != nil {
+			return wrapDriveAPIError(apiErr, "upload file")
+		}
+		result = resp
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("drive.UploadFile: %w", err)
+	}
+	return result, nil
+}
+
+// CopyFile copies a Drive file. The copy keeps the content of the original,
+// including settings that the Forms and Sheets APIs cannot set.
+func (c *DriveAPIClient) CopyFile(
+	ctx context.Context,
+	fileID string,
+	f *drive.File,
+	supportsAllDrives bool,
+) (*drive.File, error) {
+	var result *drive.File
+
+	err := WithRetry(ctx, c.retry, func() error {
+		resp, apiErr := c.service.Files.Copy(fileID, f).
+			Context(ctx).
+			SupportsAllDrives(supportsAllDrives).
+			Fields("id,name,mimeType,parents,webViewLink,trashed,createdTime").
+			Do()
+		if apiErr != nil {
+			return wrapDriveAPIError(apiErr, "copy file "+fileID)
+		}
+		result = resp
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("drive.CopyFile: %w", err)
+	}
+	return result, nil
+}
+
 // UpdateFile updates Drive metadata and/or parents.
 func (c *DriveAPIClient) UpdateFile(
 	ctx context.Context,
@@ -312,6 +399,70 @@ func (c *DriveAPIClient) UpdateFile(
 	return result, nil
 }
 
+// GetAppProperties returns the appProperties visible to this application on a
+// Drive file.
+func (c *DriveAPIClient) GetAppProperties(
+	ctx context.Context,
+	fileID string,
+	supportsAllDrives bool,
+) (map[string]string, error) {
+	var result map[string]string
+
+	err := WithRetry(ctx, c.retry, func() error {
+		resp, apiErr := c.service.Files.Get(fileID).
+			Context(ctx).
+			SupportsAllDrives(supportsAllDrives).
+			Fields("id,appProperties").
+			Do()
+		if apiErr != nil {
+			return wrapDriveAPIError(apiErr, "get app properties "+fileID)
+		}
+		result = resp.AppProperties
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("drive.GetAppProperties: %w", err)
+	}
+	return result, nil
+}
+
+// UpdateAppProperties sets appProperties on a Drive file. Properties listed in
+// remove are sent as null, which deletes them.
+func (c *DriveAPIClient) UpdateAppProperties(
+	ctx context.Context,
+	fileID string,
+	set map[string]string,
+	remove []string,
+	supportsAllDrives bool,
+) error {
+	f := &drive.File{AppProperties: map[string]string{}}
+	for k, v := range set {
+		f.AppProperties[k] = v
+	}
+	for _, k := range remove {
+		if _, ok := f.AppProperties[k]; ok {
+			continue
+		}
+		f.NullFields = append(f.NullFields, "AppProperties."+k)
+	}
+
+	err := WithRetry(ctx, c.retry, func() error {
+		_, apiErr := c.service.Files.Update(fileID, f).
+			Context(ctx).
+			SupportsAllDrives(supportsAllDrives).
+			Fields("id").
+			Do()
+		if apiErr != nil {
+			return wrapDriveAPIError(apiErr, "update app properties "+fileID)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("drive.UpdateAppProperties: %w", err)
+	}
+	return nil
+}
+
 // ListFiles lists files matching a Drive query.
 func (c *DriveAPIClient) ListFiles(
 	ctx context.Context,
@@ -84,3 +84,16 @@ func ErrorStatusCode(err error) int {
 
 	return 0
 }
+
+// CopySourceError explains a failed Drive copy of a template. With only the
+// drive.file scope, Drive reports every file the provider did not create as
+// not found.
+func (c *Client) CopySourceError(err error) error {
+	if !IsNotFound(err) {
+		return err
+	}
+	if !c.ReadDriveFiles {
+		return fmt.Errorf("the template was not found. Without read_drive_files = true in the provider configuration, only files the provider created can be copied, not templates made in the Google UI or shared with its account: %w", err)
+	}
+	return fmt.Errorf("the template was not found or is not shared with the provider's account: %w", err)
+}
@@ -116,6 +116,42 @@ func (c *FormsAPIClient) SetPublishSettings(
 	return nil
 }
 
+// ListResponses retrieves all responses of a form, following pagination.
+func (c *FormsAPIClient) ListResponses(
+	ctx context.Context,
+	formID string,
+) ([]*forms.FormResponse, error) {
+	var out []*forms.FormResponse
+	pageToken := ""
+
+	for {
+		var resp *forms.ListFormResponsesResponse
+		err := WithRetry(ctx, c.retry, func() error {
+			call := c.service.Forms.Responses.List(formID).Context(ctx)
+			if pageToken != "" {
+				call = call.PageToken(pageToken)
+			}
+			r, apiErr := call.Do()
+			if apiErr != nil {
+				return wrapGoogleAPIError(apiErr, "list responses for form "+formID)
+			}
+			resp = r
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("forms.ListResponses: %w", err)
+		}
+
+		out = append(out, resp.Responses...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return out, nil
+}
+
 // wrapGoogleAPIError converts a googleapi.Error into the appropriate
 // custom error type based on HTTP status code.
 func wrapGoogleAPIError(err error, operation string) error {
@@ -27,6 +27,9 @@ type FormsAPI interface {
 
 	// SetPublishSettings updates the publish state of a form.
 	SetPublishSettings(ctx context.Context, formID string, isPublished bool, isAccepting bool) error
+
+	// ListResponses retrieves all responses submitted to a form.
+	ListResponses(ctx context.Context, formID string) ([]*forms.FormResponse, error)
 }
 
 // DriveAPI defines the interface for Google Drive API operations on forms.
@@ -35,6 +38,10 @@ type DriveAPI interface {
 	// Returns nil if the file is already deleted (404).
 	Delete(ctx context.Context, fileID string) error
 
+	// Trash moves a Drive file (or folder) to the trash.
+	// Returns nil if the file is already deleted (404).
+	Trash(ctx context.Context, fileID string, supportsAllDrives bool) error
+
 	// GetParents returns the current parent folder IDs for a Drive file.
 	GetParents(ctx context.Context, fileID string, supportsAllDrives bool) ([]string, error)
 
@@ -58,6 +65,14 @@ type DriveAPI interface {
 	// CreateFile creates a new Drive file (including folders) and returns its metadata.
 	CreateFile(ctx context.Context, f *drive.File, supportsAllDrives bool) (*drive.File, error)
 
+	// UploadFile creates a new Drive file with the given content, uploaded as
+	// media of the given MIME type, and returns its metadata.
+	UploadFile(ctx context.Context, f *drive.File, contentType string, content []byte, supportsAllDrives bool) (*drive.File, error)
+
+	// CopyFile copies a Drive file (for example a form or spreadsheet) and
+	// returns the copy's metadata. f sets the name and parents of the copy.
+	CopyFile(ctx context.Context, fileID string, f *drive.File, supportsAllDrives bool) (*drive.File, error)
+
 	// UpdateFile updates a Drive file's metadata and/or parents.
 	// addParents/removeParents should be comma-separated folder IDs (or empty).
 	UpdateFile(ctx context.Context, fileID string, f *drive.File, addParents string, removeParents string, supportsAllDrives bool) (*drive.File, error)
@@ -65,6 +80,13 @@ type DriveAPI interface {
 	// ListFiles lists Drive files matching a query.
 	// q uses Drive Files.list query syntax (e.g. "mimeType='...'" and "name contains '...'" etc).
 	ListFiles(ctx context.Context, q string, supportsAllDrives bool) ([]*drive.File, error)
+
+	// GetAppProperties returns the provider's private appProperties on a Drive file.
+	GetAppProperties(ctx context.Context, fileID string, supportsAllDrives bool) (map[string]string, error)
+
+	// UpdateAppProperties sets the given appProperties on a Drive file and
+	// removes the properties listed in remove.
+	UpdateAppProperties(ctx context.Context, fileID string, set map[string]string, remove []string, supportsAllDrives bool) error
 }
 
 // SheetsAPI defines the interface for Google Sheets API operations.
@@ -93,4 +115,11 @@ type Client struct {
 	Forms  FormsAPI
 	Drive  DriveAPI
 	Sheets SheetsAPI
+
+	// ReadResponses reports whether the forms.responses.readonly scope was
+	// requested, without which form responses cannot be listed.
+	ReadResponses bool
+	// ReadDriveFiles reports whether the drive.readonly scope was requested,
+	// without which only files the provider created can be copied.
+	ReadDriveFiles bool
 }
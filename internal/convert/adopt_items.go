@@ -0,0 +1,51 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	forms "google.golang.org/api/forms/v1"
+)
+
+// AdoptItems correlates the items of an existing form, such as a copy of a
+// template, with desired items so that they can be updated in place instead
+// of being re-created. Desired items are matched to an unclaimed item of the
+// same kind (see ItemKind) with the same title first, then to the item of the
+// same kind at their own position. The result maps item_keys to item IDs;
+// unmatched desired items are absent.
+func AdoptItems(current []*forms.Item, desired []ItemModel) (map[string]string, error) {
+	kinds := make([]string, len(desired))
+	for i, it := range desired {
+		req, err := ItemModelToCreateRequest(it, i)
+		if err != nil {
+			return nil, err
+		}
+		kinds[i] = ItemKind(req.CreateItem.Item)
+	}
+
+	keyToID := make(map[string]string, len(desired))
+	claimed := make(map[string]bool, len(desired))
+	matches := func(i int, c *forms.Item) bool {
+		return c != nil && c.ItemId != "" && !claimed[c.ItemId] && ItemKind(c) == kinds[i]
+	}
+
+	for i, it := range desired {
+		for _, c := range current {
+			if matches(i, c) && c.Title == it.Title {
+				keyToID[it.ItemKey] = c.ItemId
+				claimed[c.ItemId] = true
+				break
+			}
+		}
+	}
+	for i, it := range desired {
+		if _, ok := keyToID[it.ItemKey]; ok || i >= len(current) {
+			continue
+		}
+		if matches(i, current[i]) {
+			keyToID[it.ItemKey] = current[i].ItemId
+			claimed[current[i].ItemId] = true
+		}
+	}
+	return keyToID, nil
+}
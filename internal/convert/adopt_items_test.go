@@ -0,0 +1,58 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+func TestAdoptItems_TitleThenPosition(t *testing.T) {
+	t.Parallel()
+
+	current := []*forms.Item{
+		liveTextItem("a", "Your name"),
+		liveTextItem("b", "Email"),
+		liveChoiceItem("c", "Colour", &forms.Option{Value: "Red"}),
+		liveTextItem("d", "Unused"),
+	}
+	desired := []ItemModel{
+		shortAnswerModel("name", "Name"),   // position 0, same kind
+		shortAnswerModel("email", "Email"), // title match
+		shortAnswerModel("phone", "Phone"), // position 2 is a choice question
+	}
+
+	got, err := AdoptItems(current, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"name": "a", "email": "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AdoptItems() = %v, want %v", got, want)
+	}
+}
+
+func TestAdoptItems_TitleMatchClaimsFirst(t *testing.T) {
+	t.Parallel()
+
+	current := []*forms.Item{
+		liveTextItem("a", "Email"),
+		liveTextItem("b", "Name"),
+	}
+	desired := []ItemModel{
+		shortAnswerModel("name", "Name"),
+		shortAnswerModel("email", "Email"),
+	}
+
+	got, err := AdoptItems(current, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"name": "b", "email": "a"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AdoptItems() = %v, want %v", got, want)
+	}
+}
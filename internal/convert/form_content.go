@@ -0,0 +1,52 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+// contentOutputOnlyKeys are output-only JSON keys that change without an edit
+// of the form. contentUri is a short-lived signed URL that every Get returns
+// anew.
+var contentOutputOnlyKeys = map[string]bool{
+	"contentUri": true,
+}
+
+// FormContentJSON returns the content of a form - its info, settings and
+// items - as normalized JSON. Revision IDs, URIs, publish settings and
+// output-only image URLs are left out, so the result changes only when the
+// form itself is edited.
+func FormContentJSON(f *forms.Form) (string, error) {
+	content, err := json.Marshal(&forms.Form{
+		Info:     f.Info,
+		Settings: f.Settings,
+		Items:    f.Items,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshaling form content: %w", err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(content, &v); err != nil {
+		return "", fmt.Errorf("normalizing form content: %w", err)
+	}
+	stripKeys(v, contentOutputOnlyKeys)
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshaling form content: %w", err)
+	}
+	return string(out), nil
+}
+
+// FormContentHash returns the hex-encoded SHA-256 hash of FormContentJSON.
+func FormContentHash(f *forms.Form) (string, error) {
+	content, err := FormContentJSON(f)
+	if err != nil {
+		return "", err
+	}
+	return HashJSON(content)
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+func TestFormContentHash(t *testing.T) {
+	t.Parallel()
+
+	form := func() *forms.Form {
+		return &forms.Form{
+			FormId:       "f",
+			RevisionId:   "rev-1",
+			ResponderUri: "https://docs.google.com/forms/d/e/x/viewform",
+			Info:         &forms.Info{Title: "Survey"},
+			Settings:     &forms.FormSettings{QuizSettings: &forms.QuizSettings{IsQuiz: false}},
+			Items:        []*forms.Item{{ItemId: "i1", Title: "Name?", TextItem: &forms.TextItem{}}},
+		}
+	}
+	hash := func(f *forms.Form) string {
+		t.Helper()
+		h, err := FormContentHash(f)
+		if err != nil {
+			t.Fatalf("FormContentHash() error: %v", err)
+		}
+		return h
+	}
+	base := hash(form())
+
+	unchanged := form()
+	unchanged.RevisionId = "rev-2"
+	unchanged.ResponderUri = "https://example.com"
+	unchanged.PublishSettings = &forms.PublishSettings{PublishState: &forms.PublishState{IsPublished: true}}
+	if hash(unchanged) != base {
+		t.Error("revision, URI and publish changes must not change the hash")
+	}
+
+	settings := form()
+	settings.Settings.QuizSettings.IsQuiz = true
+	item := form()
+	item.Items[0].Title = "Full name?"
+	info := form()
+	info.Info.Description = "New"
+	for name, f := range map[string]*forms.Form{"settings": settings, "item": item, "info": info} {
+		if hash(f) == base {
+			t.Errorf("a %s change must change the hash", name)
+		}
+	}
+}
+
+func TestFormContentJSON_IgnoresImageContentURI(t *testing.T) {
+	t.Parallel()
+
+	// Each Get returns a new signed URL for the same image.
+	get := func(contentURI string) *forms.Form {
+		image := func() *forms.Image {
+			return &forms.Image{ContentUri: contentURI, AltText: "Logo"}
+		}
+		return &forms.Form{
+			Info: &forms.Info{Title: "Survey"},
+			Items: []*forms.Item{
+				{ItemId: "i1", ImageItem: &forms.ImageItem{Image: image()}},
+				{ItemId: "i2", QuestionItem: &forms.QuestionItem{
+					Image: image(),
+					Question: &forms.Question{ChoiceQuestion: &forms.ChoiceQuestion{
+						Type:    "RADIO",
+						Options: []*forms.Option{{Value: "Red", Image: image()}},
+					}},
+				}},
+			},
+		}
+	}
+
+	first, err := FormContentJSON(get("https://lh3.example/a?sig=1"))
+	if err != nil {
+		t.Fatalf("FormContentJSON() error: %v", err)
+	}
+	second, err := FormContentJSON(get("https://lh3.example/a?sig=2"))
+	if err != nil {
+		t.Fatalf("FormContentJSON() error: %v", err)
+	}
+	if first != second {
+		t.Errorf("content differs only in contentUri:\n%s\n%s", first, second)
+	}
+	if strings.Contains(first, "contentUri") || !strings.Contains(first, "Logo") {
+		t.Errorf("content = %s, want images without contentUri", first)
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Item change kinds reported by DiffForms.
+const (
+	ItemAdded   = "added"
+	ItemRemoved = "removed"
+	ItemMoved   = "moved"
+	ItemChanged = "changed"
+)
+
+// FormDiff is a structural, item-level comparison of two forms.
+type FormDiff struct {
+	// Form lists changed form-level settings.
+	Form []FieldChange `json:"form,omitempty"`
+	// Items lists added, removed, moved and changed items, in the order of
+	// the second form (removed items last).
+	Items []ItemChange `json:"items,omitempty"`
+}
+
+// ItemChange describes one item that differs between two forms.
+type ItemChange struct {
+	// Change is ItemAdded, ItemRemoved, ItemMoved or ItemChanged.
+	Change string `json:"change"`
+	// Moved is set on changed items whose relative position also changed.
+	Moved bool   `json:"moved,omitempty"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+	// FromIndex and FromItemID locate the item in the first form, ToIndex
+	// and ToItemID in the second. They are nil/empty when absent there.
+	FromIndex  *int          `json:"from_index,omitempty"`
+	ToIndex    *int          `json:"to_index,omitempty"`
+	FromItemID string        `json:"from_item_id,omitempty"`
+	ToItemID   string        `json:"to_item_id,omitempty"`
+	Fields     []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is a changed value. From and To are rendered as JSON values.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Empty reports whether the forms are structurally equal.
+func (d FormDiff) Empty() bool {
+	return len(d.Form) == 0 && len(d.Items) == 0
+}
+
+// DiffForms compares two forms produced by FormToModel. Items are matched by
+// item ID first (copies of a form keep their item IDs), then by type and
+// title, then by title alone. Matched items are moved when they fall outside
+// the longest run of items that kept their relative order.
+func DiffForms(from, to *FormModel) FormDiff {
+	var diff FormDiff
+	diff.Form = diffFields(formFields(from), formFields(to))
+
+	match := matchItems(from.Items, to.Items)
+
+	// match[j] is the index in from of the item matched to to.Items[j].
+	var order []int
+	var orderTo []int
+	for j := range to.Items {
+		if i, ok := match[j]; ok {
+			order = append(order, i)
+			orderTo = append(orderTo, j)
+		}
+	}
+	stable := map[int]bool{}
+	for _, k := range longestIncreasingSubsequence(order) {
+		stable[orderTo[k]] = true
+	}
+
+	matchedFrom := map[int]bool{}
+	for j, item := range to.Items {
+		toIndex := j
+		i, ok := match[j]
+		if !ok {
+			diff.Items = append(diff.Items, ItemChange{
+				Change:   ItemAdded,
+				Title:    item.Title,
+				Type:     ItemType(item),
+				ToIndex:  &toIndex,
+				ToItemID: item.GoogleItemID,
+			})
+			continue
+		}
+		matchedFrom[i] = true
+		fromIndex := i
+		change := ItemChange{
+			Title:      item.Title,
+			Type:       ItemType(item),
+			FromIndex:  &fromIndex,
+			ToIndex:    &toIndex,
+			FromItemID: from.Items[i].GoogleItemID,
+			ToItemID:   item.GoogleItemID,
+			Moved:      !stable[j],
+			Fields:     diffFields(itemFields(from, from.Items[i]), itemFields(to, item)),
+		}
+		switch {
+		case len(change.Fields) > 0:
+			change.Change = ItemChanged
+		case change.Moved:
+			change.Change = ItemMoved
+			change.Moved = false
+		default:
+			continue
+		}
+		diff.Items = append(diff.Items, change)
+	}
+
+	for i, item := range from.Items {
+		if matchedFrom[i] {
+			continue
+		}
+		fromIndex := i
+		diff.Items = append(diff.Items, ItemChange{
+			Change:     ItemRemoved,
+			Title:      item.Title,
+			Type:       ItemType(item),
+			FromIndex:  &fromIndex,
+			FromItemID: item.GoogleItemID,
+		})
+	}
+	return diff
+}
+
+// matchItems pairs the items of two forms. The result maps indices in to to
+// indices in from.
+func matchItems(from, to []ItemModel) map[int]int {
+	match := map[int]int{}
+	used := map[int]bool{}
+
+	pass := func(same func(a, b ItemModel) bool) {
+		for j, b := range to {
+			if _, ok := match[j]; ok {
+				continue
+			}
+			for i, a := range from {
+				if !used[i] && same(a, b) {
+					match[j] = i
+					used[i] = true
+					break
+				}
+			}
+		}
+	}
+	pass(func(a, b ItemModel) bool { return a.GoogleItemID != "" && a.GoogleItemID == b.GoogleItemID })
+	pass(func(a, b ItemModel) bool { return a.Title == b.Title && ItemType(a) == ItemType(b) })
+	pass(func(a, b ItemModel) bool { return a.Title == b.Title })
+	return match
+}
+
+// ItemType returns the Terraform block name of the item's type, such as
+// "multiple_choice" or "section_header".
+func ItemType(item ItemModel) string {
+	v := reflect.ValueOf(item)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() == reflect.Pointer && !f.IsNil() {
+			return snakeCase(v.Type().Field(i).Name)
+		}
+	}
+	return ""
+}
+
+// formFields returns the form-level settings compared by DiffForms.
+func formFields(f *FormModel) map[string]string {
+	return map[string]string{
+		"title":                 jsonValue(f.Title),
+		"description":           jsonValue(f.Description),
+		"quiz":                  strconv.FormatBool(f.Quiz),
+		"email_collection_type": jsonValue(f.EmailCollectionType),
+	}
+}
+
+// itemFields flattens an item into comparable fields: the type, every
+// attribute of its type block, grading as "grading.<field>", and choice
+// navigation rendered with section titles so that it compares across forms.
+func itemFields(form *FormModel, item ItemModel) map[string]string {
+	fields := map[string]string{"type": jsonValue(ItemType(item))}
+	v := reflect.ValueOf(item)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Pointer || f.IsNil() {
+			continue
+		}
+		flattenStruct(fields, "", f.Elem())
+	}
+
+	if opts := itemChoiceOptions(item); opts != nil {
+		values := make([]string, 0, len(opts))
+		var nav []string
+		for _, o := range opts {
+			values = append(values, o.Value)
+			if target := navigationTarget(form, o); target != "" {
+				nav = append(nav, o.Value+" -> "+target)
+			}
+		}
+		fields["options"] = jsonValue(values)
+		if len(nav) > 0 {
+			fields["navigation"] = jsonValue(nav)
+		}
+	}
+	return fields
+}
+
+// flattenStruct adds the fields of v to out, prefixed with prefix. Nested
+// blocks are flattened with a dotted prefix. Empty strings and lists are
+// left out, so they compare as null.
+func flattenStruct(out map[string]string, prefix string, v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		// Options are compared by itemFields; content URIs are temporary.
+		if name == "Options" || name == "ContentURI" {
+			continue
+		}
+		f := v.Field(i)
+		key := prefix + snakeCase(name)
+		switch f.Kind() {
+		case reflect.Pointer:
+			if !f.IsNil() {
+				flattenStruct(out, key+".", f.Elem())
+			}
+		case reflect.String:
+			if f.String() != "" {
+				out[key] = jsonValue(f.String())
+			}
+		case reflect.Bool:
+			out[key] = strconv.FormatBool(f.Bool())
+		case reflect.Int64:
+			out[key] = strconv.FormatInt(f.Int(), 10)
+		case reflect.Slice:
+			if f.Len() > 0 {
+				out[key] = jsonValue(f.Interface())
+			}
+		}
+	}
+}
+
+// itemChoiceOptions returns the options of a choice question, or nil.
+func itemChoiceOptions(item ItemModel) []ChoiceOption {
+	switch {
+	case item.MultipleChoice != nil:
+		return item.MultipleChoice.Options
+	case item.Dropdown != nil:
+		return item.Dropdown.Options
+	case item.Checkbox != nil:
+		return item.Checkbox.Options
+	}
+	return nil
+}
+
+// navigationTarget describes where an option navigates to, naming target
+// sections by title.
+func navigationTarget(form *FormModel, o ChoiceOption) string {
+	if o.GoToAction != "" {
+		return o.GoToAction
+	}
+	for _, item := range form.Items {
+		if (o.GoToSectionID != "" && item.GoogleItemID == o.GoToSectionID) ||
+			(o.GoToSectionID == "" && o.GoToSectionKey != "" && item.ItemKey == o.GoToSectionKey) {
+			return fmt.Sprintf("section %q", item.Title)
+		}
+	}
+	if o.GoToSectionID != "" {
+		return "section " + o.GoToSectionID
+	}
+	if o.GoToSectionKey != "" {
+		return "section " + o.GoToSectionKey
+	}
+	return ""
+}
+
+// diffFields returns the fields whose values differ, sorted by name.
+func diffFields(from, to map[string]string) []FieldChange {
+	names := map[string]bool{}
+	for k := range from {
+		names[k] = true
+	}
+	for k := range to {
+		names[k] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for k := range names {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var changes []FieldChange
+	for _, k := range sorted {
+		a, b := from[k], to[k]
+		if a == b {
+			continue
+		}
+		if a == "" {
+			a = "null"
+		}
+		if b == "" {
+			b = "null"
+		}
+		changes = append(changes, FieldChange{Field: k, From: a, To: b})
+	}
+	return changes
+}
+
+func jsonValue(v interface{}) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// snakeCase converts a Go field name such as "SourceURI" to "source_uri".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
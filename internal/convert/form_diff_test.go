@@ -0,0 +1,140 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+)
+
+func diffTestForm() *FormModel {
+	return &FormModel{
+		Title: "Survey",
+		Items: []ItemModel{
+			{Title: "Attending?", GoogleItemID: "a", MultipleChoice: &MultipleChoiceBlock{
+				QuestionText: "Attending?",
+				Options: []ChoiceOption{
+					{Value: "Yes", GoToSectionID: "b"},
+					{Value: "No", GoToAction: "SUBMIT_FORM"},
+				},
+			}},
+			{Title: "Logistics", GoogleItemID: "b", SectionHeader: &SectionHeaderBlock{Title: "Logistics"}},
+			{Title: "Name", GoogleItemID: "c", ShortAnswer: &ShortAnswerBlock{QuestionText: "Name"}},
+			{Title: "Email", GoogleItemID: "d", ShortAnswer: &ShortAnswerBlock{QuestionText: "Email"}},
+		},
+	}
+}
+
+func TestDiffForms_Identical(t *testing.T) {
+	t.Parallel()
+
+	if d := DiffForms(diffTestForm(), diffTestForm()); !d.Empty() {
+		t.Fatalf("expected no differences, got %+v", d)
+	}
+}
+
+func TestDiffForms_MatchesCopiesByTitleAcrossItemIDs(t *testing.T) {
+	t.Parallel()
+
+	to := diffTestForm()
+	for i := range to.Items {
+		to.Items[i].GoogleItemID = "copy_" + to.Items[i].GoogleItemID
+	}
+	to.Items[0].MultipleChoice.Options[0].GoToSectionID = "copy_b"
+
+	if d := DiffForms(diffTestForm(), to); !d.Empty() {
+		t.Fatalf("expected a copy with new item IDs to match, got %+v", d)
+	}
+}
+
+func TestDiffForms_ReportsChanges(t *testing.T) {
+	t.Parallel()
+
+	from := diffTestForm()
+	to := diffTestForm()
+	to.Title = "Survey v2"
+	// Move Email before Name, drop Logistics, add Phone, change navigation and
+	// mark Name as required with grading.
+	to.Items = []ItemModel{
+		to.Items[0],
+		to.Items[3],
+		to.Items[2],
+		{Title: "Phone", GoogleItemID: "e", ShortAnswer: &ShortAnswerBlock{QuestionText: "Phone"}},
+	}
+	to.Items[0].MultipleChoice = &MultipleChoiceBlock{
+		QuestionText: "Attending?",
+		Options: []ChoiceOption{
+			{Value: "Yes"},
+			{Value: "No", GoToAction: "SUBMIT_FORM"},
+		},
+	}
+	to.Items[2].ShortAnswer = &ShortAnswerBlock{QuestionText: "Name", Required: true, Grading: &GradingBlock{Points: 1}}
+
+	d := DiffForms(from, to)
+
+	if want := []FieldChange{{Field: "title", From: `"Survey"`, To: `"Survey v2"`}}; !reflect.DeepEqual(d.Form, want) {
+		t.Errorf("form changes = %+v, want %+v", d.Form, want)
+	}
+
+	type summary struct {
+		change, title string
+		moved         bool
+		fields        []string
+	}
+	var got []summary
+	for _, c := range d.Items {
+		s := summary{change: c.Change, title: c.Title, moved: c.Moved}
+		for _, f := range c.Fields {
+			s.fields = append(s.fields, f.Field)
+		}
+		got = append(got, s)
+	}
+	want := []summary{
+		{change: ItemChanged, title: "Attending?", fields: []string{"navigation"}},
+		{change: ItemMoved, title: "Email"},
+		{change: ItemChanged, title: "Name", fields: []string{"grading.points", "required"}},
+		{change: ItemAdded, title: "Phone"},
+		{change: ItemRemoved, title: "Logistics"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("item changes:\n got  %+v\n want %+v", got, want)
+	}
+
+	nav := d.Items[0].Fields[0]
+	if nav.From != `["Yes -> section \"Logistics\"","No -> SUBMIT_FORM"]` || nav.To != `["No -> SUBMIT_FORM"]` {
+		t.Errorf("navigation change = %+v", nav)
+	}
+}
+
+func TestDiffForms_MovedOnly(t *testing.T) {
+	t.Parallel()
+
+	from := diffTestForm()
+	to := diffTestForm()
+	to.Items[2], to.Items[3] = to.Items[3], to.Items[2]
+
+	d := DiffForms(from, to)
+	if len(d.Items) != 1 || d.Items[0].Change != ItemMoved {
+		t.Fatalf("expected a single moved item, got %+v", d.Items)
+	}
+	if *d.Items[0].FromIndex == *d.Items[0].ToIndex {
+		t.Errorf("moved item has the same index in both forms: %+v", d.Items[0])
+	}
+}
+
+func TestItemType(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]ItemModel{
+		"multiple_choice_grid": {MultipleChoiceGrid: &MultipleChoiceGridBlock{}},
+		"date_time":            {DateTime: &DateTimeBlock{}},
+		"raw_json":             {RawJSON: &RawJSONBlock{}},
+		"":                     {},
+	}
+	for want, item := range cases {
+		if got := ItemType(item); got != want {
+			t.Errorf("ItemType = %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	forms "google.golang.org/api/forms/v1"
+)
+
+// FormSyncOptions controls the destructive changes PlanFormSync may plan.
+// Deleting or re-creating a target item detaches its collected responses, so
+// both are off by default.
+type FormSyncOptions struct {
+	// DeleteUnmatched deletes target items that correlate with no source
+	// item. Otherwise they are left in place.
+	DeleteUnmatched bool
+	// AllowTypeReplacement deletes and re-creates target items whose source
+	// item has another question type. Otherwise such items are left
+	// unchanged and reported in TypeConflicts.
+	AllowTypeReplacement bool
+}
+
+// FormSyncPlan is the batchUpdate that mirrors a source form's items into a
+// target form.
+type FormSyncPlan struct {
+	// Requests are the batchUpdate requests, in the order they must be sent.
+	Requests []*forms.Request
+	// CreateSourceIDs maps the index in Requests of each createItem request
+	// to the ID of the source item it copies.
+	CreateSourceIDs map[int]string
+	// ItemIDMap maps source item IDs to the IDs of the existing target items
+	// they correlate with (not including items that are re-created).
+	ItemIDMap map[string]string
+	// Deleted lists the target items that Requests delete.
+	Deleted []*forms.Item
+	// Replaced lists the target items that Requests delete and re-create
+	// with the question type of their source item.
+	Replaced []*forms.Item
+	// Unmatched lists the target items that correlate with no source item
+	// and are left in place.
+	Unmatched []*forms.Item
+	// TypeConflicts lists the target items whose source item has another
+	// question type. They are left unchanged.
+	TypeConflicts []*forms.Item
+}
+
+// PlanFormSync computes the targeted requests that make the target form's
+// items, and its quiz setting, match the source form. Target items keep their
+// IDs where they correlate with a source item: first through itemIDMap (the
+// source -> target mapping of the last sync), then by equal item ID (Drive
+// copies keep item IDs), then by equal title and question type. An item whose
+// type changed is re-created only with opts.AllowTypeReplacement, and target
+// items without a source item are deleted only with opts.DeleteUnmatched.
+// Target items that correlate with a source item FormToModel cannot convert
+// are left unchanged.
+//
+// Choice navigation is translated to target section IDs. Options that route to
+// a section created by this plan are left without navigation; planning again
+// after the requests were applied yields the remaining updates.
+func PlanFormSync(source, target *forms.Form, itemIDMap map[string]string, opts FormSyncOptions) (*FormSyncPlan, error) {
+	// Key every source item by its own ID so that keys and section
+	// references identify source items.
+	sourceKeys := make(map[string]string, len(source.Items))
+	for _, it := range source.Items {
+		if it != nil && it.ItemId != "" {
+			sourceKeys[it.ItemId] = it.ItemId
+		}
+	}
+	model, err := FormToModel(source, sourceKeys)
+	if err != nil {
+		return nil, err
+	}
+	targetByID := make(map[string]*forms.Item, len(target.Items))
+	for _, it := range target.Items {
+		if it != nil && it.ItemId != "" {
+			targetByID[it.ItemId] = it
+		}
+	}
+
+	out := &FormSyncPlan{CreateSourceIDs: map[int]string{}}
+	keyToID := correlateSyncItems(source.Items, target.Items, itemIDMap)
+
+	// Leave alone the target items of source items that cannot be converted
+	// and, unless replacement is allowed, those whose type changed. Neither
+	// source item is synced.
+	converted := make(map[string]bool, len(model.Items))
+	for _, it := range model.Items {
+		converted[it.ItemKey] = true
+	}
+	kept := map[string]bool{}
+	skipped := map[string]bool{}
+	for _, it := range source.Items {
+		if it == nil {
+			continue
+		}
+		targetID, ok := keyToID[it.ItemId]
+		if !ok {
+			continue
+		}
+		conflict := ItemKind(targetByID[targetID]) != ItemKind(it)
+		if converted[it.ItemId] && (!conflict || opts.AllowTypeReplacement) {
+			continue
+		}
+		if converted[it.ItemId] {
+			out.TypeConflicts = append(out.TypeConflicts, targetByID[targetID])
+			skipped[it.ItemId] = true
+		}
+		kept[targetID] = true
+		delete(keyToID, it.ItemId)
+	}
+
+	desired := make([]ItemModel, 0, len(model.Items))
+	for _, it := range model.Items {
+		if skipped[it.ItemKey] {
+			continue
+		}
+		// Source section IDs are re-resolved against the target below.
+		choices := itemChoiceOptions(it)
+		for j := range choices {
+			if choices[j].GoToSectionKey != "" {
+				choices[j].GoToSectionID = ""
+			}
+		}
+		desired = append(desired, it)
+	}
+	if err := ResolveChoiceOptionSectionIDs(desired, keyToID, true); err != nil {
+		return nil, err
+	}
+
+	claimed := make(map[string]bool, len(keyToID))
+	for _, id := range keyToID {
+		claimed[id] = true
+	}
+	managed := make(map[string]bool, len(target.Items))
+	for _, it := range target.Items {
+		if it == nil || it.ItemId == "" || kept[it.ItemId] {
+			continue
+		}
+		switch {
+		case claimed[it.ItemId]:
+			managed[it.ItemId] = true
+		case opts.DeleteUnmatched:
+			managed[it.ItemId] = true
+			out.Deleted = append(out.Deleted, it)
+		default:
+			out.Unmatched = append(out.Unmatched, it)
+		}
+	}
+
+	itemPlan, err := PlanTargetedUpdate(TargetedInput{
+		Current:              target.Items,
+		Desired:              desired,
+		KeyToID:              keyToID,
+		Managed:              managed,
+		Partial:              len(managed) < len(targetByID),
+		AllowTypeReplacement: opts.AllowTypeReplacement,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.ItemIDMap = itemPlan.KeyToID
+	for _, key := range itemPlan.ReplaceKeys {
+		out.Replaced = append(out.Replaced, targetByID[keyToID[key]])
+	}
+
+	sourceQuiz := source.Settings != nil && source.Settings.QuizSettings != nil && source.Settings.QuizSettings.IsQuiz
+	targetQuiz := target.Settings != nil && target.Settings.QuizSettings != nil && target.Settings.QuizSettings.IsQuiz
+
+	if sourceQuiz && !targetQuiz {
+		// Grading can only be set once the form is a quiz.
+		out.Requests = append(out.Requests, BuildQuizSettingsRequest(true))
+	}
+	out.Requests = append(out.Requests, itemPlan.Deletes...)
+	out.Requests = append(out.Requests, itemPlan.Moves...)
+	out.Requests = append(out.Requests, itemPlan.Updates...)
+	replaced := 0
+	for _, req := range itemPlan.Replacements {
+		if req.CreateItem != nil {
+			out.CreateSourceIDs[len(out.Requests)] = itemPlan.ReplaceKeys[replaced]
+			replaced++
+		}
+		out.Requests = append(out.Requests, req)
+	}
+	for i, req := range itemPlan.Creates {
+		out.CreateSourceIDs[len(out.Requests)] = itemPlan.CreateKeys[i]
+		out.Requests = append(out.Requests, req)
+	}
+	if !sourceQuiz && targetQuiz {
+		out.Requests = append(out.Requests, BuildQuizSettingsRequest(false))
+	}
+	return out, nil
+}
+
+// correlateSyncItems maps source item IDs to target item IDs. The mapping of
+// the last sync and equal item IDs correlate items of any question type, so
+// that a type change is recognized; equal titles only correlate items of the
+// same type.
+func correlateSyncItems(sourceItems, targetItems []*forms.Item, itemIDMap map[string]string) map[string]string {
+	targetByID := make(map[string]*forms.Item, len(targetItems))
+	for _, it := range targetItems {
+		if it != nil && it.ItemId != "" {
+			targetByID[it.ItemId] = it
+		}
+	}
+
+	keyToID := make(map[string]string, len(sourceItems))
+	claimed := make(map[string]bool, len(sourceItems))
+	claim := func(key, targetID string) {
+		keyToID[key] = targetID
+		claimed[targetID] = true
+	}
+	free := func(targetID string) bool {
+		return targetByID[targetID] != nil && !claimed[targetID]
+	}
+
+	var source []*forms.Item
+	for _, it := range sourceItems {
+		if it != nil && it.ItemId != "" {
+			source = append(source, it)
+		}
+	}
+	for _, it := range source {
+		if id, ok := itemIDMap[it.ItemId]; ok && free(id) {
+			claim(it.ItemId, id)
+		}
+	}
+	for _, it := range source {
+		if _, done := keyToID[it.ItemId]; !done && free(it.ItemId) {
+			claim(it.ItemId, it.ItemId)
+		}
+	}
+	for _, it := range source {
+		if _, done := keyToID[it.ItemId]; done {
+			continue
+		}
+		for _, t := range targetItems {
+			if t != nil && t.Title == it.Title && free(t.ItemId) && ItemKind(t) == ItemKind(it) {
+				claim(it.ItemId, t.ItemId)
+				break
+			}
+		}
+	}
+	return keyToID
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+func livePageBreak(id, title string) *forms.Item {
+	return &forms.Item{ItemId: id, Title: title, PageBreakItem: &forms.PageBreakItem{}}
+}
+
+func liveChoiceItem(id, title string, options ...*forms.Option) *forms.Item {
+	return &forms.Item{
+		ItemId: id,
+		Title:  title,
+		QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{
+				QuestionId:     "q-" + id,
+				ChoiceQuestion: &forms.ChoiceQuestion{Type: "RADIO", Options: options},
+			},
+		},
+	}
+}
+
+func TestPlanFormSync_PreservesCorrelatedTargetItems(t *testing.T) {
+	t.Parallel()
+
+	source := &forms.Form{Items: []*forms.Item{
+		liveTextItem("s1", "Name"),
+		liveTextItem("s2", "Email"),
+		liveTextItem("s3", "Phone"),
+	}}
+	target := &forms.Form{Items: []*forms.Item{
+		liveTextItem("t2", "Email"),
+		liveTextItem("t9", "Old question"),
+		liveTextItem("s1", "Full name"),
+	}}
+
+	plan, err := PlanFormSync(source, target, nil, FormSyncOptions{DeleteUnmatched: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// s1 correlates by ID (a copy of the source), s2 by title.
+	if plan.ItemIDMap["s1"] != "s1" || plan.ItemIDMap["s2"] != "t2" {
+		t.Errorf("ItemIDMap = %v, want s1->s1 and s2->t2", plan.ItemIDMap)
+	}
+	if _, ok := plan.ItemIDMap["s3"]; ok {
+		t.Errorf("s3 should be created, got ItemIDMap = %v", plan.ItemIDMap)
+	}
+
+	var deletes, moves, updates, creates int
+	for _, req := range plan.Requests {
+		switch {
+		case req.DeleteItem != nil:
+			deletes++
+		case req.MoveItem != nil:
+			moves++
+		case req.UpdateItem != nil:
+			updates++
+			if req.UpdateItem.Item.ItemId != "s1" || req.UpdateItem.Item.Title != "Name" {
+				t.Errorf("unexpected update %+v", req.UpdateItem.Item)
+			}
+		case req.CreateItem != nil:
+			creates++
+		}
+	}
+	if deletes != 1 || moves != 1 || updates != 1 || creates != 1 {
+		t.Errorf("got %d deletes, %d moves, %d updates, %d creates; want 1 of each", deletes, moves, updates, creates)
+	}
+	if len(plan.CreateSourceIDs) != 1 {
+		t.Fatalf("CreateSourceIDs = %v, want one entry", plan.CreateSourceIDs)
+	}
+	for idx, id := range plan.CreateSourceIDs {
+		if id != "s3" || plan.Requests[idx].CreateItem == nil {
+			t.Errorf("CreateSourceIDs[%d] = %q, want the create request for s3", idx, id)
+		}
+	}
+}
+
+func TestPlanFormSync_PreviousMappingWins(t *testing.T) {
+	t.Parallel()
+
+	source := &forms.Form{Items: []*forms.Item{liveTextItem("s1", "Name")}}
+	target := &forms.Form{Items: []*forms.Item{
+		liveTextItem("t1", "Name"),
+		liveTextItem("t2", "Renamed"),
+	}}
+
+	plan, err := PlanFormSync(source, target, map[string]string{"s1": "t2"}, FormSyncOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.ItemIDMap["s1"] != "t2" {
+		t.Errorf("ItemIDMap = %v, want s1->t2", plan.ItemIDMap)
+	}
+}
+
+func TestPlanFormSync_TypeChangeRecreatesItem(t *testing.T) {
+	t.Parallel()
+
+	source := &forms.Form{Items: []*forms.Item{liveChoiceItem("s1", "Q", &forms.Option{Value: "A"})}}
+	target := &forms.Form{Items: []*forms.Item{liveTextItem("s1", "Q")}}
+
+	plan, err := PlanFormSync(source, target, nil, FormSyncOptions{AllowTypeReplacement: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Requests) != 2 || plan.Requests[0].DeleteItem == nil || plan.Requests[1].CreateItem == nil {
+		t.Fatalf("requests = %+v, want delete then create", plan.Requests)
+	}
+	if len(plan.ItemIDMap) != 0 {
+		t.Errorf("ItemIDMap = %v, want empty", plan.ItemIDMap)
+	}
+	if len(plan.Replaced) != 1 || plan.Replaced[0].ItemId != "s1" {
+		t.Errorf("Replaced = %+v, want s1", plan.Replaced)
+	}
+}
+
+func TestPlanFormSync_TypeChangeWithoutReplacement(t *testing.T) {
+	t.Parallel()
+
+	source := &forms.Form{Items: []*forms.Item{
+		liveChoiceItem("s1", "Q", &forms.Option{Value: "A"}),
+		liveTextItem("s2", "Other"),
+	}}
+	target := &forms.Form{Items: []*forms.Item{liveTextItem("s1", "Q")}}
+
+	plan, err := PlanFormSync(source, target, nil, FormSyncOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.TypeConflicts) != 1 || plan.TypeConflicts[0].ItemId != "s1" {
+		t.Errorf("TypeConflicts = %+v, want s1", plan.TypeConflicts)
+	}
+	for _, req := range plan.Requests {
+		if req.DeleteItem != nil {
+			t.Errorf("unexpected delete %+v", req.DeleteItem)
+		}
+	}
+	if len(plan.Requests) != 1 || plan.Requests[0].CreateItem == nil {
+		t.Errorf("requests = %+v, want only the create of s2", plan.Requests)
+	}
+}
+
+func TestPlanFormSync_KeepsUnmatchedAndUnconvertedTargetItems(t *testing.T) {
+	t.Parallel()
+
+	image := &forms.Item{ItemId: "s2", Title: "Logo", ImageItem: &forms.ImageItem{}}
+	source := &forms.Form{Items: []*forms.Item{liveTextItem("s1", "Name"), image}}
+	target := &forms.Form{Items: []*forms.Item{
+		liveTextItem("t9", "Old question"),
+		liveTextItem("s1", "Name"),
+		{ItemId: "s2", Title: "Logo", ImageItem: &forms.ImageItem{}},
+	}}
+
+	for _, deleteUnmatched := range []bool{false, true} {
+		plan, err := PlanFormSync(source, target, nil, FormSyncOptions{DeleteUnmatched: deleteUnmatched})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var deleted []string
+		for _, req := range plan.Requests {
+			if req.DeleteItem != nil {
+				deleted = append(deleted, target.Items[req.DeleteItem.Location.Index].ItemId)
+			}
+		}
+		if !deleteUnmatched {
+			if len(deleted) != 0 {
+				t.Errorf("deleted %v, want nothing by default", deleted)
+			}
+			if len(plan.Unmatched) != 1 || plan.Unmatched[0].ItemId != "t9" {
+				t.Errorf("Unmatched = %+v, want t9", plan.Unmatched)
+			}
+			continue
+		}
+		// The image item cannot be synced, so its target copy is kept.
+		if len(deleted) != 1 || deleted[0] != "t9" {
+			t.Errorf("deleted %v, want only t9", deleted)
+		}
+		if len(plan.Deleted) != 1 || plan.Deleted[0].ItemId != "t9" {
+			t.Errorf("Deleted = %+v, want t9", plan.Deleted)
+		}
+	}
+}
+
+func TestPlanFormSync_TranslatesNavigation(t *testing.T) {
+	t.Parallel()
+
+	source := &forms.Form{Items: []*forms.Item{
+		liveChoiceItem("s1", "Route",
+			&forms.Option{Value: "Old", GoToSectionId: "s2"},
+			&forms.Option{Value: "New", GoToSectionId: "s3"},
+		),
+		livePageBreak("s2", "Existing section"),
+		livePageBreak("s3", "New section"),
+	}}
+	target := &forms.Form{Items: []*forms.Item{
+		liveChoiceItem("t1", "Route", &forms.Option{Value: "Old"}, &forms.Option{Value: "New"}),
+		livePageBreak("t2", "Existing section"),
+	}}
+
+	plan, err := PlanFormSync(source, target, nil, FormSyncOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var update *forms.Item
+	for _, req := range plan.Requests {
+		if req.UpdateItem != nil && req.UpdateItem.Item.ItemId == "t1" {
+			update = req.UpdateItem.Item
+		}
+	}
+	if update == nil {
+		t.Fatalf("expected an update of t1, got %+v", plan.Requests)
+	}
+	opts := update.QuestionItem.Question.ChoiceQuestion.Options
+	if opts[0].GoToSectionId != "t2" {
+		t.Errorf("option Old routes to %q, want target section t2", opts[0].GoToSectionId)
+	}
+	if opts[1].GoToSectionId != "" {
+		t.Errorf("option New routes to %q, want no navigation until s3 exists", opts[1].GoToSectionId)
+	}
+
+	// Once the new section exists in the target, a second plan adds the
+	// remaining navigation.
+	target.Items = []*forms.Item{
+		liveChoiceItem("t1", "Route",
+			&forms.Option{Value: "Old", GoToSectionId: "t2"},
+			&forms.Option{Value: "New"},
+		),
+		livePageBreak("t2", "Existing section"),
+		livePageBreak("t3", "New section"),
+	}
+	idMap := map[string]string{"s1": "t1", "s2": "t2", "s3": "t3"}
+	plan, err = PlanFormSync(source, target, idMap, FormSyncOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Requests) != 1 || plan.Requests[0].UpdateItem == nil {
+		t.Fatalf("requests = %+v, want a single update", plan.Requests)
+	}
+	if got := plan.Requests[0].UpdateItem.Item.QuestionItem.Question.ChoiceQuestion.Options[1].GoToSectionId; got != "t3" {
+		t.Errorf("option New routes to %q, want t3", got)
+	}
+}
+
+func TestPlanFormSync_QuizSetting(t *testing.T) {
+	t.Parallel()
+
+	quiz := &forms.FormSettings{QuizSettings: &forms.QuizSettings{IsQuiz: true}}
+	source := &forms.Form{Settings: quiz, Items: []*forms.Item{liveTextItem("s1", "Q")}}
+	target := &forms.Form{}
+
+	plan, err := PlanFormSync(source, target, nil, FormSyncOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Requests) != 2 || plan.Requests[0].UpdateSettings == nil || plan.Requests[1].CreateItem == nil {
+		t.Fatalf("requests = %+v, want quiz settings before the create", plan.Requests)
+	}
+
+	plan, err = PlanFormSync(&forms.Form{}, &forms.Form{Settings: quiz}, nil, FormSyncOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Requests) != 1 || plan.Requests[0].UpdateSettings == nil {
+		t.Fatalf("requests = %+v, want a quiz settings update", plan.Requests)
+	}
+}
@@ -5,14 +5,34 @@ package convert
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 
+	"golang.org/x/text/unicode/norm"
 	forms "google.golang.org/api/forms/v1"
 )
 
+// FormToModelOptions tunes how FormToModelWithOptions converts items.
+type FormToModelOptions struct {
+	// RawItemKeys lists item_keys that are managed through raw_json. Those
+	// items are read back as RawJSON blocks instead of typed blocks.
+	RawItemKeys map[string]bool
+
+	// SlugKeys derives item_keys for items missing from the key map from
+	// their titles (e.g. "What is your email?" -> "what_is_your_email")
+	// instead of their position, so keys survive items being inserted.
+	SlugKeys bool
+}
+
 // FormToModel converts a Forms API response into a convert.FormModel.
 // existingKeyMap maps Google item IDs to Terraform item_key values; if nil
 // or missing an entry, keys are auto-generated as "item_N".
 func FormToModel(form *forms.Form, existingKeyMap map[string]string) (*FormModel, error) {
+	return FormToModelWithOptions(form, existingKeyMap, FormToModelOptions{})
+}
+
+// FormToModelWithOptions is FormToModel with conversion options.
+func FormToModelWithOptions(form *forms.Form, existingKeyMap map[string]string, opts FormToModelOptions) (*FormModel, error) {
 	model := &FormModel{
 		ID:           form.FormId,
 		ResponderURI: form.ResponderUri,
@@ -32,8 +52,25 @@ func FormToModel(form *forms.Form, existingKeyMap map[string]string) (*FormModel
 		model.EmailCollectionType = form.Settings.EmailCollectionType
 	}
 
+	if opts.SlugKeys {
+		existingKeyMap = slugItemKeys(form.Items, existingKeyMap)
+	}
+
 	for i, apiItem := range form.Items {
 		itemKey := resolveItemKey(apiItem.ItemId, i, existingKeyMap)
+		if opts.RawItemKeys[itemKey] {
+			raw, err := RawItemJSON(apiItem)
+			if err != nil {
+				return nil, fmt.Errorf("item[%d] (%s): %w", i, apiItem.ItemId, err)
+			}
+			model.Items = append(model.Items, ItemModel{
+				Title:        apiItem.Title,
+				ItemKey:      itemKey,
+				GoogleItemID: apiItem.ItemId,
+				RawJSON:      &RawJSONBlock{JSON: raw},
+			})
+			continue
+		}
 		converted, err := FormItemToItemModel(apiItem, itemKey, existingKeyMap)
 		if err != nil {
 			return nil, fmt.Errorf("item[%d] (%s): %w", i, apiItem.ItemId, err)
@@ -58,6 +95,87 @@ func resolveItemKey(googleID string, index int, keyMap map[string]string) string
 	return fmt.Sprintf("item_%d", index)
 }
 
+// maxSlugKeyLength caps the length of title-derived item_keys.
+const maxSlugKeyLength = 50
+
+// slugItemKeys returns a copy of keyMap extended with a title-derived key for
+// every item that has none. Slugs are unique within the form: repeated titles
+// get a numeric suffix ("name", "name_2"), and items without a usable title
+// keep the positional "item_N" key.
+func slugItemKeys(items []*forms.Item, keyMap map[string]string) map[string]string {
+	out := make(map[string]string, len(items))
+	used := make(map[string]bool, len(items))
+	for id, key := range keyMap {
+		out[id] = key
+		used[key] = true
+	}
+
+	for i, item := range items {
+		if item == nil || item.ItemId == "" {
+			continue
+		}
+		if _, ok := out[item.ItemId]; ok {
+			continue
+		}
+		base := slugify(item.Title)
+		if base == "" {
+			base = fmt.Sprintf("item_%d", i)
+		}
+		key := base
+		for n := 2; used[key]; n++ {
+			key = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[key] = true
+		out[item.ItemId] = key
+	}
+	return out
+}
+
+// slugify lowercases s and joins its runs of letters and digits with
+// underscores, so that the result matches the item_key format
+// [a-z][a-z0-9_]*. Accented letters are folded to ASCII ("é" becomes "e") and
+// other non-ASCII characters, such as CJK, are dropped. A leading digit is
+// prefixed with "q_".
+func slugify(s string) string {
+	var b strings.Builder
+	sep := false
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		if unicode.Is(unicode.Mn, r) {
+			// A combining mark of a decomposed letter.
+			continue
+		}
+		fold, ok := slugLetters[r]
+		switch {
+		case ok:
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			fold = string(r)
+		default:
+			sep = true
+			continue
+		}
+		if sep && b.Len() > 0 {
+			b.WriteByte('_')
+		}
+		sep = false
+		b.WriteString(fold)
+	}
+	slug := b.String()
+	if slug != "" && slug[0] >= '0' && slug[0] <= '9' {
+		slug = "q_" + slug
+	}
+	if len(slug) > maxSlugKeyLength {
+		slug = strings.TrimRight(slug[:maxSlugKeyLength], "_")
+	}
+	return slug
+}
+
+// slugLetters folds the Latin letters that do not decompose into an ASCII
+// letter and combining marks.
+var slugLetters = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d",
+	'ł': "l", 'þ': "th", 'ı': "i", 'ħ': "h", 'ŧ': "t",
+}
+
 // FormItemToItemModel converts a single Forms API Item into a convert.ItemModel.
 // Returns nil (without error) for truly unsupported item types (images, videos).
 func FormItemToItemModel(item *forms.Item, itemKey string, keyMap map[string]string) (*ItemModel, error) {
@@ -326,20 +444,59 @@ func convertScaleQuestion(title string, q *forms.Question) *ScaleBlock {
 }
 
 // convertGrading maps Forms API Grading to a GradingBlock, or nil if absent.
+// A single answer is returned as CorrectAnswer; several answers are returned
+// as CorrectAnswers.
 func convertGrading(g *forms.Grading) *GradingBlock {
 	if g == nil {
 		return nil
 	}
 	gb := &GradingBlock{Points: g.PointValue}
 
-	if g.CorrectAnswers != nil && len(g.CorrectAnswers.Answers) > 0 {
-		gb.CorrectAnswer = g.CorrectAnswers.Answers[0].Value
+	if g.CorrectAnswers != nil {
+		answers := make([]string, 0, len(g.CorrectAnswers.Answers))
+		for _, a := range g.CorrectAnswers.Answers {
+			if a != nil {
+				answers = append(answers, a.Value)
+			}
+		}
+		switch len(answers) {
+		case 0:
+		case 1:
+			gb.CorrectAnswer = answers[0]
+		default:
+			gb.CorrectAnswers = answers
+		}
 	}
-	if g.WhenRight != nil {
-		gb.FeedbackCorrect = g.WhenRight.Text
+	gb.FeedbackCorrect = convertFeedback(g.WhenRight, FeedbackCorrect, gb)
+	gb.FeedbackIncorrect = convertFeedback(g.WhenWrong, FeedbackIncorrect, gb)
+	gb.GeneralFeedback = convertFeedback(g.GeneralFeedback, FeedbackGeneral, gb)
+	return gb
+}
+
+// convertFeedback appends feedback material to gb (tagged with appliesTo) and
+// returns the feedback text.
+func convertFeedback(f *forms.Feedback, appliesTo string, gb *GradingBlock) string {
+	if f == nil {
+		return ""
 	}
-	if g.WhenWrong != nil {
-		gb.FeedbackIncorrect = g.WhenWrong.Text
+	for _, m := range f.Material {
+		if m == nil {
+			continue
+		}
+		if m.Link != nil {
+			gb.FeedbackLinks = append(gb.FeedbackLinks, FeedbackLink{
+				AppliesTo:   appliesTo,
+				URI:         m.Link.Uri,
+				DisplayText: m.Link.DisplayText,
+			})
+		}
+		if m.Video != nil {
+			gb.FeedbackVideos = append(gb.FeedbackVideos, FeedbackVideo{
+				AppliesTo:   appliesTo,
+				YoutubeURI:  m.Video.YoutubeUri,
+				DisplayText: m.Video.DisplayText,
+			})
+		}
 	}
-	return gb
+	return f.Text
 }
@@ -4,6 +4,8 @@
 package convert
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	forms "google.golang.org/api/forms/v1"
@@ -275,6 +277,72 @@ func TestFormToModel_WithGrading(t *testing.T) {
 	}
 }
 
+func TestFormToModel_WithMultipleAnswersAndFeedbackMaterial(t *testing.T) {
+	form := &forms.Form{
+		FormId: "form-graded",
+		Info:   &forms.Info{Title: "Graded"},
+		Items: []*forms.Item{
+			{
+				ItemId: "item-g1",
+				Title:  "Pick the primes",
+				QuestionItem: &forms.QuestionItem{
+					Question: &forms.Question{
+						ChoiceQuestion: &forms.ChoiceQuestion{
+							Type:    "CHECKBOX",
+							Options: []*forms.Option{{Value: "2"}, {Value: "3"}, {Value: "4"}},
+						},
+						Grading: &forms.Grading{
+							PointValue: 2,
+							CorrectAnswers: &forms.CorrectAnswers{
+								Answers: []*forms.CorrectAnswer{{Value: "2"}, {Value: "3"}},
+							},
+							WhenWrong: &forms.Feedback{
+								Material: []*forms.ExtraMaterial{
+									{Link: &forms.TextLink{Uri: "https://example.com/primes", DisplayText: "Primes"}},
+								},
+							},
+							GeneralFeedback: &forms.Feedback{
+								Text: "Primes have exactly two divisors.",
+								Material: []*forms.ExtraMaterial{
+									{Video: &forms.VideoLink{YoutubeUri: "https://youtu.be/abc", DisplayText: "Intro"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	model, err := FormToModel(form, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cb := model.Items[0].Checkbox
+	if cb == nil || cb.Grading == nil {
+		t.Fatal("expected Checkbox with Grading")
+	}
+	g := cb.Grading
+	if g.CorrectAnswer != "" {
+		t.Errorf("CorrectAnswer = %q, want empty when several answers are set", g.CorrectAnswer)
+	}
+	if len(g.CorrectAnswers) != 2 || g.CorrectAnswers[0] != "2" || g.CorrectAnswers[1] != "3" {
+		t.Errorf("CorrectAnswers = %v, want [2 3]", g.CorrectAnswers)
+	}
+	if g.GeneralFeedback != "Primes have exactly two divisors." {
+		t.Errorf("GeneralFeedback = %q", g.GeneralFeedback)
+	}
+	wantLink := FeedbackLink{AppliesTo: FeedbackIncorrect, URI: "https://example.com/primes", DisplayText: "Primes"}
+	if len(g.FeedbackLinks) != 1 || g.FeedbackLinks[0] != wantLink {
+		t.Errorf("FeedbackLinks = %+v", g.FeedbackLinks)
+	}
+	wantVideo := FeedbackVideo{AppliesTo: FeedbackGeneral, YoutubeURI: "https://youtu.be/abc", DisplayText: "Intro"}
+	if len(g.FeedbackVideos) != 1 || g.FeedbackVideos[0] != wantVideo {
+		t.Errorf("FeedbackVideos = %+v", g.FeedbackVideos)
+	}
+}
+
 func TestFormToModel_WithTextItem(t *testing.T) {
 	form := &forms.Form{
 		FormId: "form-text",
@@ -597,3 +665,80 @@ func TestFormItemToItemModel_NilQuestionItem(t *testing.T) {
 		t.Error("expected nil result for unsupported item type")
 	}
 }
+
+func TestFormToModel_SlugKeys(t *testing.T) {
+	t.Parallel()
+
+	text := func(id, title string) *forms.Item {
+		return &forms.Item{
+			ItemId: id,
+			Title:  title,
+			QuestionItem: &forms.QuestionItem{
+				Question: &forms.Question{TextQuestion: &forms.TextQuestion{}},
+			},
+		}
+	}
+	form := &forms.Form{
+		FormId: "f",
+		Items: []*forms.Item{
+			text("a", "What is your e-mail?"),
+			text("b", "Name"),
+			text("c", "Name"),
+			text("d", "2024 plans"),
+			text("e", "???"),
+			text("f", "Known"),
+		},
+	}
+
+	model, err := FormToModelWithOptions(form, map[string]string{"f": "kept"}, FormToModelOptions{SlugKeys: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"what_is_your_e_mail", "name", "name_2", "q_2024_plans", "item_4", "kept"}
+	if len(model.Items) != len(want) {
+		t.Fatalf("expected %d items, got %d", len(want), len(model.Items))
+	}
+	for i, w := range want {
+		if got := model.Items[i].ItemKey; got != w {
+			t.Errorf("item %d key = %q, want %q", i, got, w)
+		}
+	}
+}
+
+func TestSlugify_TruncatesLongTitles(t *testing.T) {
+	t.Parallel()
+
+	got := slugify(strings.Repeat("word ", 30))
+	if len(got) > maxSlugKeyLength {
+		t.Fatalf("slug %q is longer than %d bytes", got, maxSlugKeyLength)
+	}
+	if strings.HasSuffix(got, "_") {
+		t.Fatalf("slug %q ends with an underscore", got)
+	}
+}
+
+// itemKeyFormat is the documented item_key format.
+var itemKeyFormat = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+func TestSlugify_NonASCIITitles(t *testing.T) {
+	t.Parallel()
+
+	for title, want := range map[string]string{
+		"Café à côté?":         "cafe_a_cote",
+		"Größe (cm)":           "grosse_cm",
+		"Ærø Ølst":             "aero_olst",
+		"名前":                   "",
+		"お名前 / Name":           "name",
+		"1. 年齢":                "q_1",
+		"Łódź 2024 — ¿dónde?":  "lodz_2024_donde",
+		"Ελληνικά and English": "and_english",
+	} {
+		got := slugify(title)
+		if got != want {
+			t.Errorf("slugify(%q) = %q, want %q", title, got, want)
+		}
+		if got != "" && !itemKeyFormat.MatchString(got) {
+			t.Errorf("slugify(%q) = %q does not match %s", title, got, itemKeyFormat)
+		}
+	}
+}
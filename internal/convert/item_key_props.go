@@ -0,0 +1,143 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ItemKeyPropertyPrefix prefixes the Drive appProperties that hold the
+// item_key mapping of a form. "<prefix>n" holds the number of chunks and
+// "<prefix>0", "<prefix>1", ... hold the chunks.
+const ItemKeyPropertyPrefix = "tf_item_keys_"
+
+const (
+	// maxAppPropertyBytes is the Drive limit for a property key plus value.
+	maxAppPropertyBytes = 124
+	// maxItemKeyPropertyChunks keeps the chunks and the count property within
+	// the limit of 30 private properties per application and file.
+	maxItemKeyPropertyChunks = 29
+)
+
+// itemKeyCountProperty names the property holding the chunk count.
+const itemKeyCountProperty = ItemKeyPropertyPrefix + "n"
+
+// EncodeItemKeyProperties encodes a google_item_id -> item_key map as Drive
+// appProperties. The map is serialized as a URL query string (so any item_key
+// survives), compressed, base64 encoded and split into chunks that fit the
+// per-property size limit. An empty map encodes as no properties.
+//
+// The chunks hold about 3,100 base64 characters, or 2,300 bytes of
+// compressed mapping. The mapping usually compresses to less than half its
+// size, so about 200 items with item_keys of 15 to 20 characters fit.
+func EncodeItemKeyProperties(keyMap map[string]string) (map[string]string, error) {
+	values := url.Values{}
+	for id, key := range keyMap {
+		if id == "" || key == "" {
+			continue
+		}
+		values.Set(id, key)
+	}
+
+	props := map[string]string{}
+	if len(values) == 0 {
+		return props, nil
+	}
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(w, values.Encode()); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(buf.Bytes())
+
+	for i := 0; encoded != ""; i++ {
+		if i == maxItemKeyPropertyChunks {
+			return nil, fmt.Errorf(
+				"item_key mapping for %d items does not fit in %d Drive appProperties",
+				len(values), maxItemKeyPropertyChunks,
+			)
+		}
+		name := ItemKeyPropertyPrefix + strconv.Itoa(i)
+		n := maxAppPropertyBytes - len(name)
+		if n > len(encoded) {
+			n = len(encoded)
+		}
+		props[name] = encoded[:n]
+		encoded = encoded[n:]
+	}
+	props[itemKeyCountProperty] = strconv.Itoa(len(props))
+	return props, nil
+}
+
+// DecodeItemKeyProperties recovers the google_item_id -> item_key map written
+// by EncodeItemKeyProperties. It returns nil when props hold no mapping or the
+// chunks are incomplete.
+func DecodeItemKeyProperties(props map[string]string) map[string]string {
+	count, err := strconv.Atoi(props[itemKeyCountProperty])
+	if err != nil || count <= 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < count; i++ {
+		chunk, ok := props[ItemKeyPropertyPrefix+strconv.Itoa(i)]
+		if !ok {
+			return nil
+		}
+		b.WriteString(chunk)
+	}
+
+	compressed, err := base64.RawURLEncoding.DecodeString(b.String())
+	if err != nil {
+		return nil
+	}
+	query, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		return nil
+	}
+	values, err := url.ParseQuery(string(query))
+	if err != nil {
+		return nil
+	}
+	keyMap := make(map[string]string, len(values))
+	for id, keys := range values {
+		if len(keys) > 0 && keys[0] != "" {
+			keyMap[id] = keys[0]
+		}
+	}
+	if len(keyMap) == 0 {
+		return nil
+	}
+	return keyMap
+}
+
+// StaleItemKeyProperties returns the item_key properties present in existing
+// but not in desired, which should be removed from the file.
+func StaleItemKeyProperties(existing, desired map[string]string) []string {
+	var stale []string
+	for name := range existing {
+		if !strings.HasPrefix(name, ItemKeyPropertyPrefix) {
+			continue
+		}
+		if _, ok := desired[name]; !ok {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestItemKeyProperties_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	keyMap := map[string]string{}
+	for i := 0; i < 40; i++ {
+		keyMap[fmt.Sprintf("%08x", i)] = fmt.Sprintf("question_%d", i)
+	}
+	keyMap["odd"] = "key with = & , and ünïcode"
+
+	props, err := EncodeItemKeyProperties(keyMap)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(props) < 3 {
+		t.Fatalf("expected the mapping to span several properties, got %d", len(props))
+	}
+	for name, value := range props {
+		if !strings.HasPrefix(name, ItemKeyPropertyPrefix) {
+			t.Errorf("property %q lacks prefix %q", name, ItemKeyPropertyPrefix)
+		}
+		if n := len(name) + len(value); n > maxAppPropertyBytes {
+			t.Errorf("property %q is %d bytes, limit %d", name, n, maxAppPropertyBytes)
+		}
+	}
+
+	if got := DecodeItemKeyProperties(props); !reflect.DeepEqual(got, keyMap) {
+		t.Fatalf("round trip mismatch:\n got  %v\n want %v", got, keyMap)
+	}
+}
+
+// realisticItemKeyMap returns n items with random 8-character item IDs, like
+// the Forms API assigns, and item_keys of of 15 to 20 characters made of words.
+func realisticItemKeyMap(n int) map[string]string {
+	words := []string{
+		"name", "email", "address", "phone", "favourite", "colour", "department",
+		"experience", "rating", "comments", "start", "date", "preferred", "contact",
+		"method", "team", "size", "budget", "feedback", "overall", "satisfaction",
+		"reason", "role", "location",
+	}
+	rng := rand.New(rand.NewSource(1))
+	keyMap := make(map[string]string, n)
+	for len(keyMap) < n {
+		key := fmt.Sprintf("%s_%s_%d", words[rng.Intn(len(words))], words[rng.Intn(len(words))], len(keyMap))
+		keyMap[fmt.Sprintf("%08x", rng.Uint32())] = key
+	}
+	return keyMap
+}
+
+func TestItemKeyProperties_RealisticFormFits(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{150, 200} {
+		keyMap := realisticItemKeyMap(n)
+		props, err := EncodeItemKeyProperties(keyMap)
+		if err != nil {
+			t.Fatalf("%d items: unexpected error: %v", n, err)
+		}
+		if got := DecodeItemKeyProperties(props); !reflect.DeepEqual(got, keyMap) {
+			t.Fatalf("%d items: round trip mismatch", n)
+		}
+	}
+}
+
+func TestItemKeyProperties_Empty(t *testing.T) {
+	t.Parallel()
+
+	props, err := EncodeItemKeyProperties(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(props) != 0 {
+		t.Fatalf("expected no properties, got %v", props)
+	}
+	if got := DecodeItemKeyProperties(props); got != nil {
+		t.Fatalf("expected nil map, got %v", got)
+	}
+}
+
+func TestItemKeyProperties_TooLarge(t *testing.T) {
+	t.Parallel()
+
+	keyMap := realisticItemKeyMap(300)
+	if _, err := EncodeItemKeyProperties(keyMap); err == nil {
+		t.Fatal("expected an error for a mapping that exceeds the property limits")
+	}
+}
+
+func TestDecodeItemKeyProperties_MissingChunk(t *testing.T) {
+	t.Parallel()
+
+	props := map[string]string{
+		ItemKeyPropertyPrefix + "n": "2",
+		ItemKeyPropertyPrefix + "0": "abc=q1",
+	}
+	if got := DecodeItemKeyProperties(props); got != nil {
+		t.Fatalf("expected nil for incomplete chunks, got %v", got)
+	}
+}
+
+func TestStaleItemKeyProperties(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]string{
+		ItemKeyPropertyPrefix + "n": "3",
+		ItemKeyPropertyPrefix + "0": "a",
+		ItemKeyPropertyPrefix + "1": "b",
+		ItemKeyPropertyPrefix + "2": "c",
+		"other_app_property":        "x",
+	}
+	desired := map[string]string{
+		ItemKeyPropertyPrefix + "n": "1",
+		ItemKeyPropertyPrefix + "0": "a",
+	}
+	got := StaleItemKeyProperties(existing, desired)
+	want := []string{ItemKeyPropertyPrefix + "1", ItemKeyPropertyPrefix + "2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("stale = %v, want %v", got, want)
+	}
+}
@@ -59,6 +59,10 @@ func ApplyItemModelToExistingItem(existing *forms.Item, desired ItemModel) (bool
 	existing.Title = desired.Title
 
 	switch {
+	case desired.RawJSON != nil:
+		// The raw item replaces the existing one wholesale (keeping its IDs).
+		return applyRawJSON(existing, desired.RawJSON)
+
 	case desired.MultipleChoice != nil:
 		if existing.QuestionItem == nil || existing.QuestionItem.Question == nil {
 			return true, nil
@@ -52,6 +52,10 @@ func ItemModelToCreateRequest(item ItemModel, index int) (*forms.Request, error)
 		buildVideo(formItem, item.Video)
 	case item.SectionHeader != nil:
 		buildSectionHeader(formItem, item.SectionHeader)
+	case item.RawJSON != nil:
+		if err := buildRawJSON(formItem, item.RawJSON); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("item %q has no question block set", item.Title)
 	}
@@ -335,18 +339,44 @@ func applyGrading(q *forms.Question, g *GradingBlock) {
 	}
 	grading := &forms.Grading{PointValue: g.Points}
 
-	if g.CorrectAnswer != "" {
+	if answers := g.Answers(); len(answers) > 0 {
 		grading.CorrectAnswers = &forms.CorrectAnswers{
-			Answers: []*forms.CorrectAnswer{{Value: g.CorrectAnswer}},
+			Answers: make([]*forms.CorrectAnswer, 0, len(answers)),
+		}
+		for _, a := range answers {
+			grading.CorrectAnswers.Answers = append(grading.CorrectAnswers.Answers, &forms.CorrectAnswer{Value: a})
 		}
 	}
-	if g.FeedbackCorrect != "" {
-		grading.WhenRight = &forms.Feedback{Text: g.FeedbackCorrect}
+	grading.WhenRight = buildFeedback(g.FeedbackCorrect, FeedbackCorrect, g)
+	grading.WhenWrong = buildFeedback(g.FeedbackIncorrect, FeedbackIncorrect, g)
+	grading.GeneralFeedback = buildFeedback(g.GeneralFeedback, FeedbackGeneral, g)
+	q.Grading = grading
+}
+
+// buildFeedback returns a Feedback with the given text and any links/videos
+// targeted at appliesTo, or nil when there is nothing to send.
+func buildFeedback(text, appliesTo string, g *GradingBlock) *forms.Feedback {
+	var material []*forms.ExtraMaterial
+	for _, l := range g.FeedbackLinks {
+		if l.AppliesTo != appliesTo {
+			continue
+		}
+		material = append(material, &forms.ExtraMaterial{
+			Link: &forms.TextLink{Uri: l.URI, DisplayText: l.DisplayText},
+		})
 	}
-	if g.FeedbackIncorrect != "" {
-		grading.WhenWrong = &forms.Feedback{Text: g.FeedbackIncorrect}
+	for _, v := range g.FeedbackVideos {
+		if v.AppliesTo != appliesTo {
+			continue
+		}
+		material = append(material, &forms.ExtraMaterial{
+			Video: &forms.VideoLink{YoutubeUri: v.YoutubeURI, DisplayText: v.DisplayText},
+		})
 	}
-	q.Grading = grading
+	if text == "" && len(material) == 0 {
+		return nil
+	}
+	return &forms.Feedback{Text: text, Material: material}
 }
 
 // ItemsToCreateRequests converts a slice of ItemModels into an ordered
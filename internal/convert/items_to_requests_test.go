@@ -102,6 +102,53 @@ func TestMultipleChoiceToRequest_WithGrading(t *testing.T) {
 	}
 }
 
+func TestCheckboxToRequest_WithMultipleAnswersAndFeedbackMaterial(t *testing.T) {
+	t.Parallel()
+	item := ItemModel{
+		Title: "Pick the primes",
+		Checkbox: &CheckboxBlock{
+			Options: []ChoiceOption{{Value: "2"}, {Value: "3"}, {Value: "4"}},
+			Grading: &GradingBlock{
+				Points:          2,
+				CorrectAnswers:  []string{"2", "3"},
+				FeedbackCorrect: "Nice.",
+				GeneralFeedback: "Primes have exactly two divisors.",
+				FeedbackLinks: []FeedbackLink{
+					{AppliesTo: FeedbackIncorrect, URI: "https://example.com/primes", DisplayText: "Primes"},
+				},
+				FeedbackVideos: []FeedbackVideo{
+					{AppliesTo: FeedbackGeneral, YoutubeURI: "https://youtu.be/abc", DisplayText: "Intro"},
+				},
+			},
+		},
+	}
+
+	req, err := ItemModelToCreateRequest(item, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	g := req.CreateItem.Item.QuestionItem.Question.Grading
+	if g == nil || g.CorrectAnswers == nil {
+		t.Fatal("expected grading with correct answers")
+	}
+	if len(g.CorrectAnswers.Answers) != 2 || g.CorrectAnswers.Answers[0].Value != "2" || g.CorrectAnswers.Answers[1].Value != "3" {
+		t.Errorf("unexpected correct answers: %+v", g.CorrectAnswers.Answers)
+	}
+	if g.WhenRight == nil || g.WhenRight.Text != "Nice." || len(g.WhenRight.Material) != 0 {
+		t.Errorf("unexpected when_right: %+v", g.WhenRight)
+	}
+	if g.WhenWrong == nil || g.WhenWrong.Text != "" || len(g.WhenWrong.Material) != 1 ||
+		g.WhenWrong.Material[0].Link == nil || g.WhenWrong.Material[0].Link.Uri != "https://example.com/primes" {
+		t.Errorf("unexpected when_wrong: %+v", g.WhenWrong)
+	}
+	if g.GeneralFeedback == nil || g.GeneralFeedback.Text != "Primes have exactly two divisors." ||
+		len(g.GeneralFeedback.Material) != 1 || g.GeneralFeedback.Material[0].Video == nil ||
+		g.GeneralFeedback.Material[0].Video.YoutubeUri != "https://youtu.be/abc" {
+		t.Errorf("unexpected general_feedback: %+v", g.GeneralFeedback)
+	}
+}
+
 func TestMultipleChoiceToRequest_Required(t *testing.T) {
 	t.Parallel()
 	item := ItemModel{
@@ -67,3 +67,137 @@ func HashJSON(jsonStr string) (string, error) {
 	sum := sha256.Sum256([]byte(normalized))
 	return fmt.Sprintf("%x", sum), nil
 }
+
+// ContentJSONItemKey returns the synthetic item_key used for the content_json
+// item at index i.
+func ContentJSONItemKey(i int) string {
+	return fmt.Sprintf("content_json_%d", i)
+}
+
+// ContentJSONCorrelation correlates content_json items with existing items
+// so that content_json forms can be updated with targeted requests.
+type ContentJSONCorrelation struct {
+	// Items holds one RawJSON item per content_json entry, keyed by
+	// ContentJSONItemKey.
+	Items []ItemModel
+	// KeyToID maps synthetic item_keys to existing Google item IDs.
+	KeyToID map[string]string
+	// Managed is the set of existing item IDs owned by content_json.
+	Managed map[string]bool
+}
+
+// CorrelateContentJSON matches content_json items against the live items of a
+// form. An item is correlated, in order of preference, by:
+//
+//  1. an explicit "itemId" in its JSON,
+//  2. an unclaimed managed item with equivalent content,
+//  3. the ID stored for its index by the previous apply (storedIDs), when the
+//     item kind is unchanged.
+//
+// Items that cannot be correlated are created. In full management mode every
+// live item is owned by content_json; in partial mode only items recorded in
+// storedIDs or referenced by an explicit itemId are.
+func CorrelateContentJSON(jsonStr string, current []*forms.Item, storedIDs []string, partial bool) (*ContentJSONCorrelation, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal([]byte(jsonStr), &raws); err != nil {
+		return nil, fmt.Errorf("parsing declarative JSON: %w", err)
+	}
+
+	byID := make(map[string]*forms.Item, len(current))
+	for _, it := range current {
+		if it != nil && it.ItemId != "" {
+			byID[it.ItemId] = it
+		}
+	}
+
+	corr := &ContentJSONCorrelation{
+		Items:   make([]ItemModel, len(raws)),
+		KeyToID: map[string]string{},
+		Managed: map[string]bool{},
+	}
+	explicit := make([]string, len(raws))
+	parsed := make([]*forms.Item, len(raws))
+	for i, raw := range raws {
+		item, err := ParseRawItemJSON(string(raw))
+		if err != nil {
+			return nil, fmt.Errorf("content_json[%d]: %w", i, err)
+		}
+		parsed[i] = item
+		explicit[i] = item.ItemId
+		corr.Items[i] = ItemModel{
+			Title:   item.Title,
+			ItemKey: ContentJSONItemKey(i),
+			RawJSON: &RawJSONBlock{JSON: string(raw)},
+		}
+	}
+
+	if partial {
+		for _, id := range storedIDs {
+			if _, ok := byID[id]; ok {
+				corr.Managed[id] = true
+			}
+		}
+		for _, id := range explicit {
+			if _, ok := byID[id]; ok && id != "" {
+				corr.Managed[id] = true
+			}
+		}
+	} else {
+		for id := range byID {
+			corr.Managed[id] = true
+		}
+	}
+
+	claimed := map[string]bool{}
+	assign := func(i int, id string) {
+		corr.KeyToID[corr.Items[i].ItemKey] = id
+		claimed[id] = true
+	}
+
+	// Pass 1: explicit itemId.
+	for i, id := range explicit {
+		if id == "" || claimed[id] {
+			continue
+		}
+		if _, ok := byID[id]; ok {
+			assign(i, id)
+		}
+	}
+
+	// Pass 2: equivalent content.
+	for i := range corr.Items {
+		if _, done := corr.KeyToID[corr.Items[i].ItemKey]; done || explicit[i] != "" {
+			continue
+		}
+		for _, it := range current {
+			if it == nil || claimed[it.ItemId] || !corr.Managed[it.ItemId] {
+				continue
+			}
+			live, err := RawItemJSON(it)
+			if err != nil {
+				continue
+			}
+			if RawItemJSONEquivalent(corr.Items[i].RawJSON.JSON, live) {
+				assign(i, it.ItemId)
+				break
+			}
+		}
+	}
+
+	// Pass 3: stored index, when the item kind is unchanged.
+	for i := range corr.Items {
+		if _, done := corr.KeyToID[corr.Items[i].ItemKey]; done || explicit[i] != "" || i >= len(storedIDs) {
+			continue
+		}
+		id := storedIDs[i]
+		live, ok := byID[id]
+		if !ok || claimed[id] || !corr.Managed[id] {
+			continue
+		}
+		if ItemKind(live) == ItemKind(parsed[i]) {
+			assign(i, id)
+		}
+	}
+
+	return corr, nil
+}
@@ -5,6 +5,8 @@ package convert
 
 import (
 	"testing"
+
+	forms "google.golang.org/api/forms/v1"
 )
 
 // ---------------------------------------------------------------------------
@@ -257,3 +259,77 @@ func TestDeclarativeJSONToRequests_AssignsCorrectIndices(t *testing.T) {
 		}
 	}
 }
+
+// ---------------------------------------------------------------------------
+// CorrelateContentJSON
+// ---------------------------------------------------------------------------
+
+func TestCorrelateContentJSON_ExplicitItemID(t *testing.T) {
+	current := []*forms.Item{liveTextItem("a", "A"), liveTextItem("b", "B")}
+	jsonStr := `[{"itemId":"b","title":"B renamed","questionItem":{"question":{"textQuestion":{}}}}]`
+
+	corr, err := CorrelateContentJSON(jsonStr, current, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := corr.KeyToID[ContentJSONItemKey(0)]; got != "b" {
+		t.Errorf("item 0 correlated to %q, want b", got)
+	}
+	if !corr.Managed["a"] || !corr.Managed["b"] {
+		t.Errorf("managed = %v, want all current items", corr.Managed)
+	}
+}
+
+func TestCorrelateContentJSON_MatchesEquivalentContent(t *testing.T) {
+	current := []*forms.Item{liveTextItem("a", "A"), liveTextItem("b", "B")}
+	jsonStr := `[{"title":"B","questionItem":{"question":{"textQuestion":{}}}},{"title":"A","questionItem":{"question":{"textQuestion":{}}}}]`
+
+	corr, err := CorrelateContentJSON(jsonStr, current, []string{"a", "b"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if corr.KeyToID[ContentJSONItemKey(0)] != "b" || corr.KeyToID[ContentJSONItemKey(1)] != "a" {
+		t.Errorf("KeyToID = %v, want reordered match by content", corr.KeyToID)
+	}
+}
+
+func TestCorrelateContentJSON_StoredIndexRequiresSameKind(t *testing.T) {
+	current := []*forms.Item{liveTextItem("a", "A"), liveTextItem("b", "B")}
+	jsonStr := `[
+		{"title":"A edited","questionItem":{"question":{"textQuestion":{}}}},
+		{"title":"B","questionItem":{"question":{"choiceQuestion":{"type":"RADIO","options":[{"value":"x"}]}}}}
+	]`
+
+	corr, err := CorrelateContentJSON(jsonStr, current, []string{"a", "b"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := corr.KeyToID[ContentJSONItemKey(0)]; got != "a" {
+		t.Errorf("item 0 correlated to %q, want a", got)
+	}
+	if _, ok := corr.KeyToID[ContentJSONItemKey(1)]; ok {
+		t.Errorf("item 1 should not be correlated after a kind change: %v", corr.KeyToID)
+	}
+}
+
+func TestCorrelateContentJSON_PartialManagesOnlyOwnedItems(t *testing.T) {
+	current := []*forms.Item{liveTextItem("x", "Unmanaged"), liveTextItem("a", "A")}
+	jsonStr := `[{"title":"Unmanaged","questionItem":{"question":{"textQuestion":{}}}}]`
+
+	corr, err := CorrelateContentJSON(jsonStr, current, []string{"a"}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if corr.Managed["x"] || !corr.Managed["a"] {
+		t.Errorf("managed = %v, want only a", corr.Managed)
+	}
+	if got := corr.KeyToID[ContentJSONItemKey(0)]; got != "a" {
+		t.Errorf("item 0 correlated to %q, want the owned item a rather than the unmanaged match", got)
+	}
+}
+
+func TestCorrelateContentJSON_InvalidJSON_Error(t *testing.T) {
+	if _, err := CorrelateContentJSON(`{not json`, nil, nil, false); err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NavigationStart names the first section of a form, which has no page
+// break, and NavigationSubmit the end of the form. Neither can be an item_key.
+const (
+	NavigationStart  = "(start)"
+	NavigationSubmit = "(submit)"
+)
+
+// NavigationEdge is a way from one section to another. ItemKey and Options
+// name the question and the options that lead there; both are empty for the
+// default continuation of a section.
+type NavigationEdge struct {
+	From    string
+	To      string
+	ItemKey string
+	Options []string
+}
+
+// NavigationGraph is the section navigation of a form. Sections holds the
+// section keys in form order, NavigationStart first.
+type NavigationGraph struct {
+	Sections []string
+	Edges    []NavigationEdge
+
+	// OptionalBranches holds the item_keys of branching questions that are
+	// not required. Respondents who skip them continue to the next section.
+	OptionalBranches []string
+}
+
+// branchingQuestion is a multiple_choice or dropdown item with at least one
+// navigating option.
+type branchingQuestion struct {
+	key      string
+	required bool
+	options  []ChoiceOption
+}
+
+// BuildNavigationGraph builds the section navigation of items. A section
+// continues to the next one (or to the end of the form) unless it has a
+// required branching question; the options of branching questions add an
+// edge per destination. sectionNext maps section keys to the section they
+// continue to instead of the next one, which options without navigation
+// also go to. Only multiple_choice and dropdown options navigate, like in
+// the Forms editor.
+func BuildNavigationGraph(items []ItemModel, sectionNext map[string]string) *NavigationGraph {
+	g := &NavigationGraph{Sections: []string{NavigationStart}}
+	sectionIDs := map[string]string{}
+	questions := [][]branchingQuestion{nil}
+	for _, it := range items {
+		if isPageBreakItem(it) {
+			g.Sections = append(g.Sections, it.ItemKey)
+			if it.GoogleItemID != "" {
+				sectionIDs[it.GoogleItemID] = it.ItemKey
+			}
+			questions = append(questions, nil)
+			continue
+		}
+		if q, ok := branchingQuestionOf(it); ok {
+			last := len(questions) - 1
+			questions[last] = append(questions[last], q)
+		}
+	}
+
+	for i, from := range g.Sections {
+		next := NavigationSubmit
+		if i+1 < len(g.Sections) {
+			next = g.Sections[i+1]
+		}
+		if to, ok := sectionNext[from]; ok {
+			next = to
+		}
+		continues := true
+		for _, q := range questions[i] {
+			if q.required {
+				continues = false
+			} else {
+				g.OptionalBranches = append(g.OptionalBranches, q.key)
+			}
+			g.Edges = append(g.Edges, optionEdges(from, next, q, sectionIDs)...)
+		}
+		if continues {
+			g.Edges = append(g.Edges, NavigationEdge{From: from, To: next})
+		}
+	}
+	return g
+}
+
+// optionEdges returns one edge per destination of the options of q, in the
+// order the destinations first appear.
+func optionEdges(from, next string, q branchingQuestion, sectionIDs map[string]string) []NavigationEdge {
+	var edges []NavigationEdge
+	index := map[string]int{}
+	for _, o := range q.options {
+		to := next
+		switch {
+		case o.GoToAction == "SUBMIT_FORM":
+			to = NavigationSubmit
+		case o.GoToAction == "RESTART_FORM":
+			to = NavigationStart
+		case o.GoToSectionKey != "":
+			to = o.GoToSectionKey
+		case o.GoToSectionID != "":
+			to = o.GoToSectionID
+			if key, ok := sectionIDs[o.GoToSectionID]; ok {
+				to = key
+			}
+		}
+		i, ok := index[to]
+		if !ok {
+			i = len(edges)
+			index[to] = i
+			edges = append(edges, NavigationEdge{From: from, To: to, ItemKey: q.key})
+		}
+		edges[i].Options = append(edges[i].Options, o.Value)
+	}
+	return edges
+}
+
+// branchingQuestionOf returns the branching question of a multiple_choice or
+// dropdown item; ok is false when none of its options navigate.
+func branchingQuestionOf(it ItemModel) (branchingQuestion, bool) {
+	q := branchingQuestion{key: it.ItemKey}
+	switch {
+	case it.MultipleChoice != nil:
+		q.required, q.options = it.MultipleChoice.Required, it.MultipleChoice.Options
+	case it.Dropdown != nil:
+		q.required, q.options = it.Dropdown.Required, it.Dropdown.Options
+	default:
+		return q, false
+	}
+	for _, o := range q.options {
+		if o.GoToAction != "" || o.GoToSectionKey != "" || o.GoToSectionID != "" {
+			return q, true
+		}
+	}
+	return q, false
+}
+
+func isPageBreakItem(it ItemModel) bool {
+	return it.SectionHeader != nil || (it.RawJSON != nil && IsRawPageBreak(it.RawJSON.JSON))
+}
+
+// Unreachable returns the sections no path from the start of the form leads
+// to, in form order.
+func (g *NavigationGraph) Unreachable() []string {
+	seen := map[string]bool{NavigationStart: true}
+	queue := []string{NavigationStart}
+	for len(queue) > 0 {
+		from := queue[0]
+		queue = queue[1:]
+		for _, e := range g.Edges {
+			if e.From == from && !seen[e.To] {
+				seen[e.To] = true
+				queue = append(queue, e.To)
+			}
+		}
+	}
+
+	var out []string
+	for _, s := range g.Sections {
+		if !seen[s] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Cycles returns the loops respondents can go through, each as the path of
+// sections from the first section of the loop back to it. Edges back to the
+// start of the form are RESTART_FORM options and are not counted as loops.
+func (g *NavigationGraph) Cycles() [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	var stack []string
+	var cycles [][]string
+
+	var visit func(string)
+	visit = func(from string) {
+		state[from] = visiting
+		stack = append(stack, from)
+		for _, to := range g.successors(from) {
+			switch state[to] {
+			case unvisited:
+				visit(to)
+			case visiting:
+				for i, s := range stack {
+					if s == to {
+						cycle := append(append([]string{}, stack[i:]...), to)
+						cycles = append(cycles, cycle)
+						break
+					}
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[from] = done
+	}
+	for _, s := range g.Sections {
+		if state[s] == unvisited {
+			visit(s)
+		}
+	}
+	return cycles
+}
+
+// successors returns the distinct destinations of the edges from a section,
+// without the start of the form, in edge order.
+func (g *NavigationGraph) successors(from string) []string {
+	var out []string
+	seen := map[string]bool{}
+	for _, e := range g.Edges {
+		if e.From == from && e.To != NavigationStart && !seen[e.To] {
+			seen[e.To] = true
+			out = append(out, e.To)
+		}
+	}
+	return out
+}
+
+// DOT renders the graph in the Graphviz DOT language. Edges taken through
+// options are labelled "item_key: option, option".
+func (g *NavigationGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph navigation {\n")
+	for _, s := range g.Sections {
+		b.WriteString("  " + strconv.Quote(s) + ";\n")
+	}
+	b.WriteString("  " + strconv.Quote(NavigationSubmit) + ";\n")
+	for _, e := range g.Edges {
+		b.WriteString("  " + strconv.Quote(e.From) + " -> " + strconv.Quote(e.To))
+		if e.ItemKey != "" {
+			b.WriteString(" [label=" + strconv.Quote(e.ItemKey+": "+strings.Join(e.Options, ", ")) + "]")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+)
+
+func navSection(key string) ItemModel {
+	return ItemModel{ItemKey: key, SectionHeader: &SectionHeaderBlock{Title: key}}
+}
+
+func navChoice(key string, required bool, options ...ChoiceOption) ItemModel {
+	return ItemModel{ItemKey: key, MultipleChoice: &MultipleChoiceBlock{
+		QuestionText: key,
+		Required:     required,
+		Options:      options,
+	}}
+}
+
+func TestBuildNavigationGraph_Edges(t *testing.T) {
+	t.Parallel()
+
+	g := BuildNavigationGraph([]ItemModel{
+		navChoice("kind", true,
+			ChoiceOption{Value: "A", GoToSectionKey: "part_a"},
+			ChoiceOption{Value: "B"},
+			ChoiceOption{Value: "C", GoToSectionKey: "part_a"},
+			ChoiceOption{Value: "Done", GoToAction: "SUBMIT_FORM"},
+		),
+		navSection("part_b"),
+		{ItemKey: "why", ShortAnswer: &ShortAnswerBlock{QuestionText: "Why?"}},
+		navSection("part_a"),
+	}, nil)
+
+	want := []NavigationEdge{
+		{From: NavigationStart, To: "part_a", ItemKey: "kind", Options: []string{"A", "C"}},
+		{From: NavigationStart, To: "part_b", ItemKey: "kind", Options: []string{"B"}},
+		{From: NavigationStart, To: NavigationSubmit, ItemKey: "kind", Options: []string{"Done"}},
+		{From: "part_b", To: "part_a"},
+		{From: "part_a", To: NavigationSubmit},
+	}
+	if !reflect.DeepEqual(g.Edges, want) {
+		t.Errorf("edges =\n%+v\nwant\n%+v", g.Edges, want)
+	}
+	if len(g.Unreachable()) != 0 || len(g.Cycles()) != 0 || len(g.OptionalBranches) != 0 {
+		t.Errorf("unexpected findings: unreachable %v, cycles %v, optional %v", g.Unreachable(), g.Cycles(), g.OptionalBranches)
+	}
+}
+
+func TestBuildNavigationGraph_Findings(t *testing.T) {
+	t.Parallel()
+
+	g := BuildNavigationGraph([]ItemModel{
+		navChoice("start_over", false, ChoiceOption{Value: "Yes", GoToAction: "RESTART_FORM"}),
+		navSection("loop"),
+		navChoice("again", true,
+			ChoiceOption{Value: "Again", GoToSectionKey: "loop"},
+			ChoiceOption{Value: "Stop", GoToAction: "SUBMIT_FORM"},
+		),
+		navSection("orphan"),
+	}, nil)
+
+	if got := g.Unreachable(); !reflect.DeepEqual(got, []string{"orphan"}) {
+		t.Errorf("Unreachable() = %v, want [orphan]", got)
+	}
+	if got := g.Cycles(); !reflect.DeepEqual(got, [][]string{{"loop", "loop"}}) {
+		t.Errorf("Cycles() = %v, want [[loop loop]]", got)
+	}
+	if !reflect.DeepEqual(g.OptionalBranches, []string{"start_over"}) {
+		t.Errorf("OptionalBranches = %v, want [start_over]", g.OptionalBranches)
+	}
+}
+
+func TestBuildNavigationGraph_SectionNext(t *testing.T) {
+	t.Parallel()
+
+	g := BuildNavigationGraph([]ItemModel{
+		navSection("part_1"),
+		navChoice("color", false, ChoiceOption{Value: "Red"}, ChoiceOption{Value: "Blue", GoToAction: "SUBMIT_FORM"}),
+		navSection("skipped"),
+		navSection("part_2"),
+	}, map[string]string{"part_1": "part_2"})
+
+	want := []NavigationEdge{
+		{From: NavigationStart, To: "part_1"},
+		{From: "part_1", To: "part_2", ItemKey: "color", Options: []string{"Red"}},
+		{From: "part_1", To: NavigationSubmit, ItemKey: "color", Options: []string{"Blue"}},
+		{From: "part_1", To: "part_2"},
+		{From: "skipped", To: "part_2"},
+		{From: "part_2", To: NavigationSubmit},
+	}
+	if !reflect.DeepEqual(g.Edges, want) {
+		t.Errorf("edges =\n%+v\nwant\n%+v", g.Edges, want)
+	}
+	if got := g.Unreachable(); !reflect.DeepEqual(got, []string{"skipped"}) {
+		t.Errorf("Unreachable() = %v, want [skipped]", got)
+	}
+}
+
+func TestNavigationGraph_DOT(t *testing.T) {
+	t.Parallel()
+
+	g := BuildNavigationGraph([]ItemModel{
+		{ItemKey: "s1", GoogleItemID: "gid_s1", RawJSON: &RawJSONBlock{JSON: `{"title":"S1","pageBreakItem":{}}`}},
+		navChoice("color", true, ChoiceOption{Value: "Red", GoToSectionID: "gid_s1"}, ChoiceOption{Value: "Blue", GoToAction: "SUBMIT_FORM"}),
+	}, nil)
+
+	want := `digraph navigation {
+  "(start)";
+  "s1";
+  "(submit)";
+  "(start)" -> "s1";
+  "s1" -> "s1" [label="color: Red"];
+  "s1" -> "(submit)" [label="color: Blue"];
+}
+`
+	if got := g.DOT(); got != want {
+		t.Errorf("DOT() =\n%s\nwant\n%s", got, want)
+	}
+}
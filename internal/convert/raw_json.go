@@ -0,0 +1,267 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+// RawJSONBlock carries a single Forms API Item as JSON. It is an escape hatch
+// for item features that have no typed block (option images, question types
+// not modelled by the provider, ...).
+type RawJSONBlock struct {
+	JSON string
+}
+
+// rawOutputOnlyKeys are JSON keys assigned by the API. They are stripped from
+// raw item JSON on create/read-back and carried over from the live item on
+// update so that IDs are preserved and change detection stays stable.
+var rawOutputOnlyKeys = map[string]bool{
+	"itemId":     true,
+	"questionId": true,
+	"contentUri": true,
+}
+
+// ParseRawItemJSON parses a raw_json item definition into a Forms API Item.
+func ParseRawItemJSON(jsonStr string) (*forms.Item, error) {
+	var item forms.Item
+	if err := json.Unmarshal([]byte(jsonStr), &item); err != nil {
+		return nil, fmt.Errorf("parsing raw item JSON: %w", err)
+	}
+	return &item, nil
+}
+
+// RawItemTitle returns the title of a raw_json item, or "" if it cannot be
+// parsed.
+func RawItemTitle(jsonStr string) string {
+	item, err := ParseRawItemJSON(jsonStr)
+	if err != nil {
+		return ""
+	}
+	return item.Title
+}
+
+// IsRawPageBreak reports whether a raw_json item is a page break (section).
+func IsRawPageBreak(jsonStr string) bool {
+	item, err := ParseRawItemJSON(jsonStr)
+	return err == nil && item.PageBreakItem != nil
+}
+
+// buildRawJSON replaces fi with the item described by the raw JSON, with
+// API-assigned fields removed so the item can be created in any form.
+func buildRawJSON(fi *forms.Item, raw *RawJSONBlock) error {
+	m, err := rawItemMap(raw.JSON)
+	if err != nil {
+		return err
+	}
+	stripKeys(m, rawOutputOnlyKeys)
+	item, err := itemFromMap(m)
+	if err != nil {
+		return err
+	}
+	*fi = *item
+	return nil
+}
+
+// applyRawJSON replaces existing with the item described by the raw JSON,
+// keeping the API-assigned IDs of the existing item. It returns true when the
+// raw item is a different kind of item than existing.
+func applyRawJSON(existing *forms.Item, raw *RawJSONBlock) (bool, error) {
+	desired, err := rawItemMap(raw.JSON)
+	if err != nil {
+		return true, err
+	}
+	desiredItem, err := itemFromMap(desired)
+	if err != nil {
+		return true, err
+	}
+	if ItemKind(desiredItem) != ItemKind(existing) {
+		return true, nil
+	}
+
+	current, err := itemToMap(existing)
+	if err != nil {
+		return true, err
+	}
+	stripKeys(desired, rawOutputOnlyKeys)
+	copyMissingKeys(desired, current, rawOutputOnlyKeys)
+
+	updated, err := itemFromMap(desired)
+	if err != nil {
+		return true, err
+	}
+	*existing = *updated
+	return false, nil
+}
+
+// RawItemJSON renders a live Forms API item as raw_json, without the
+// API-assigned fields.
+func RawItemJSON(item *forms.Item) (string, error) {
+	m, err := itemToMap(item)
+	if err != nil {
+		return "", err
+	}
+	stripKeys(m, rawOutputOnlyKeys)
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("marshal raw item JSON: %w", err)
+	}
+	return string(b), nil
+}
+
+// ItemKind returns a coarse type descriptor for a Forms API item, such as
+// "question/choice/RADIO" or "pageBreak". Two items of different kinds cannot
+// be converted into each other with an updateItem request.
+func ItemKind(item *forms.Item) string {
+	if item == nil {
+		return ""
+	}
+	switch {
+	case item.QuestionItem != nil && item.QuestionItem.Question != nil:
+		q := item.QuestionItem.Question
+		switch {
+		case q.ChoiceQuestion != nil:
+			return "question/choice/" + q.ChoiceQuestion.Type
+		case q.TextQuestion != nil:
+			if q.TextQuestion.Paragraph {
+				return "question/paragraph"
+			}
+			return "question/text"
+		case q.ScaleQuestion != nil:
+			return "question/scale"
+		case q.DateQuestion != nil:
+			return "question/date"
+		case q.TimeQuestion != nil:
+			return "question/time"
+		case q.RatingQuestion != nil:
+			return "question/rating"
+		case q.FileUploadQuestion != nil:
+			return "question/fileUpload"
+		}
+		return "question"
+	case item.QuestionGroupItem != nil:
+		if item.QuestionGroupItem.Grid != nil && item.QuestionGroupItem.Grid.Columns != nil {
+			return "questionGroup/grid/" + item.QuestionGroupItem.Grid.Columns.Type
+		}
+		return "questionGroup"
+	case item.PageBreakItem != nil:
+		return "pageBreak"
+	case item.TextItem != nil:
+		return "text"
+	case item.ImageItem != nil:
+		return "image"
+	case item.VideoItem != nil:
+		return "video"
+	}
+	return ""
+}
+
+func rawItemMap(jsonStr string) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &m); err != nil {
+		return nil, fmt.Errorf("parsing raw item JSON: %w", err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("parsing raw item JSON: expected a JSON object")
+	}
+	return m, nil
+}
+
+func itemToMap(item *forms.Item) (map[string]interface{}, error) {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("marshal item: %w", err)
+	}
+	return rawItemMap(string(b))
+}
+
+func itemFromMap(m map[string]interface{}) (*forms.Item, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("marshal raw item JSON: %w", err)
+	}
+	return ParseRawItemJSON(string(b))
+}
+
+// stripKeys removes the given keys at any depth.
+func stripKeys(v interface{}, keys map[string]bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if keys[k] {
+				delete(t, k)
+				continue
+			}
+			stripKeys(child, keys)
+		}
+	case []interface{}:
+		for _, child := range t {
+			stripKeys(child, keys)
+		}
+	}
+}
+
+// copyMissingKeys copies the given keys from src into dst at matching paths
+// (arrays are matched by index) where dst does not set them.
+func copyMissingKeys(dst, src interface{}, keys map[string]bool) {
+	switch d := dst.(type) {
+	case map[string]interface{}:
+		s, ok := src.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for k, sv := range s {
+			dv, exists := d[k]
+			if keys[k] {
+				if !exists {
+					d[k] = sv
+				}
+				continue
+			}
+			if exists {
+				copyMissingKeys(dv, sv, keys)
+			}
+		}
+	case []interface{}:
+		s, ok := src.([]interface{})
+		if !ok {
+			return
+		}
+		for i := range d {
+			if i < len(s) {
+				copyMissingKeys(d[i], s[i], keys)
+			}
+		}
+	}
+}
+
+// RawItemJSONEquivalent reports whether two raw_json documents describe the
+// same item, ignoring formatting, API-assigned fields and default values.
+func RawItemJSONEquivalent(a, b string) bool {
+	na, err := normalizeRawItemJSON(a)
+	if err != nil {
+		return false
+	}
+	nb, err := normalizeRawItemJSON(b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
+
+func normalizeRawItemJSON(jsonStr string) (string, error) {
+	m, err := rawItemMap(jsonStr)
+	if err != nil {
+		return "", err
+	}
+	stripKeys(m, rawOutputOnlyKeys)
+	item, err := itemFromMap(m)
+	if err != nil {
+		return "", err
+	}
+	return RawItemJSON(item)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+const rawRadioWithImage = `{
+  "itemId": "copied-id",
+  "title": "Pick a flag",
+  "questionItem": {
+    "question": {
+      "questionId": "copied-qid",
+      "required": true,
+      "choiceQuestion": {
+        "type": "RADIO",
+        "options": [
+          {"value": "France", "image": {"sourceUri": "https://example.com/fr.png"}},
+          {"value": "Italy"}
+        ]
+      }
+    }
+  }
+}`
+
+func liveRadioItem() *forms.Item {
+	return &forms.Item{
+		ItemId: "live-id",
+		Title:  "Pick a flag",
+		QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{
+				QuestionId: "live-qid",
+				Required:   true,
+				ChoiceQuestion: &forms.ChoiceQuestion{
+					Type: "RADIO",
+					Options: []*forms.Option{
+						{Value: "France", Image: &forms.Image{SourceUri: "https://example.com/fr.png", ContentUri: "https://lh3.example/fr"}},
+						{Value: "Italy"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRawJSONToRequest_StripsAPIAssignedIDs(t *testing.T) {
+	t.Parallel()
+	item := ItemModel{ItemKey: "flag", RawJSON: &RawJSONBlock{JSON: rawRadioWithImage}}
+
+	req, err := ItemModelToCreateRequest(item, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := req.CreateItem.Item
+	if got.ItemId != "" || got.QuestionItem.Question.QuestionId != "" {
+		t.Errorf("expected IDs to be stripped, got itemId=%q questionId=%q", got.ItemId, got.QuestionItem.Question.QuestionId)
+	}
+	if got.Title != "Pick a flag" {
+		t.Errorf("title = %q", got.Title)
+	}
+	if img := got.QuestionItem.Question.ChoiceQuestion.Options[0].Image; img == nil || img.SourceUri != "https://example.com/fr.png" {
+		t.Errorf("expected option image to be kept, got %+v", img)
+	}
+	if req.CreateItem.Location.Index != 3 {
+		t.Errorf("index = %d, want 3", req.CreateItem.Location.Index)
+	}
+}
+
+func TestRawJSONToRequest_InvalidJSON(t *testing.T) {
+	t.Parallel()
+	item := ItemModel{ItemKey: "bad", RawJSON: &RawJSONBlock{JSON: "[1,2]"}}
+	if _, err := ItemModelToCreateRequest(item, 0); err == nil {
+		t.Fatal("expected error for non-object raw JSON")
+	}
+}
+
+func TestApplyDesiredItem_RawJSON_UnchangedKeepsIDs(t *testing.T) {
+	t.Parallel()
+	desired := ItemModel{ItemKey: "flag", RawJSON: &RawJSONBlock{JSON: rawRadioWithImage}}
+
+	updated, changed, needsReplace, err := ApplyDesiredItem(liveRadioItem(), desired)
+	if err != nil || needsReplace {
+		t.Fatalf("unexpected result: needsReplace=%v err=%v", needsReplace, err)
+	}
+	if changed {
+		t.Error("expected no change when raw JSON matches the live item")
+	}
+	if updated.ItemId != "live-id" || updated.QuestionItem.Question.QuestionId != "live-qid" {
+		t.Errorf("expected live IDs to be preserved, got %q/%q", updated.ItemId, updated.QuestionItem.Question.QuestionId)
+	}
+}
+
+func TestApplyDesiredItem_RawJSON_Changed(t *testing.T) {
+	t.Parallel()
+	raw := strings.Replace(rawRadioWithImage, `"Italy"`, `"Spain"`, 1)
+	desired := ItemModel{ItemKey: "flag", RawJSON: &RawJSONBlock{JSON: raw}}
+
+	updated, changed, needsReplace, err := ApplyDesiredItem(liveRadioItem(), desired)
+	if err != nil || needsReplace {
+		t.Fatalf("unexpected result: needsReplace=%v err=%v", needsReplace, err)
+	}
+	if !changed {
+		t.Fatal("expected change")
+	}
+	if updated.QuestionItem.Question.ChoiceQuestion.Options[1].Value != "Spain" {
+		t.Errorf("option not updated: %+v", updated.QuestionItem.Question.ChoiceQuestion.Options[1])
+	}
+	if updated.ItemId != "live-id" {
+		t.Errorf("itemId = %q, want live-id", updated.ItemId)
+	}
+}
+
+func TestApplyDesiredItem_RawJSON_KindChangeNeedsReplace(t *testing.T) {
+	t.Parallel()
+	desired := ItemModel{ItemKey: "flag", RawJSON: &RawJSONBlock{JSON: `{"title":"Now text","textItem":{}}`}}
+
+	_, _, needsReplace, err := ApplyDesiredItem(liveRadioItem(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !needsReplace {
+		t.Fatal("expected needsReplace for a different item kind")
+	}
+}
+
+func TestFormToModelWithOptions_RawItemKeys(t *testing.T) {
+	t.Parallel()
+	form := &forms.Form{
+		FormId: "f1",
+		Items:  []*forms.Item{liveRadioItem()},
+	}
+
+	model, err := FormToModelWithOptions(form, map[string]string{"live-id": "flag"}, FormToModelOptions{
+		RawItemKeys: map[string]bool{"flag": true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(model.Items) != 1 || model.Items[0].RawJSON == nil {
+		t.Fatalf("expected one raw item, got %+v", model.Items)
+	}
+	it := model.Items[0]
+	if it.ItemKey != "flag" || it.GoogleItemID != "live-id" || it.MultipleChoice != nil {
+		t.Errorf("unexpected item: %+v", it)
+	}
+	if strings.Contains(it.RawJSON.JSON, "live-id") || strings.Contains(it.RawJSON.JSON, "contentUri") {
+		t.Errorf("expected API-assigned fields to be stripped: %s", it.RawJSON.JSON)
+	}
+	if !RawItemJSONEquivalent(it.RawJSON.JSON, rawRadioWithImage) {
+		t.Errorf("expected read-back JSON to be equivalent to config:\n%s", it.RawJSON.JSON)
+	}
+}
+
+func TestRawItemJSONEquivalent(t *testing.T) {
+	t.Parallel()
+	a := `{"title":"T","questionItem":{"question":{"required":false,"textQuestion":{}}}}`
+	b := `{"questionItem":{"question":{"textQuestion":{}}},"title":"T","itemId":"x"}`
+	if !RawItemJSONEquivalent(a, b) {
+		t.Error("expected equivalent")
+	}
+	if RawItemJSONEquivalent(a, `{"title":"U","questionItem":{"question":{"textQuestion":{}}}}`) {
+		t.Error("expected different titles to differ")
+	}
+}
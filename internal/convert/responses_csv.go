@@ -0,0 +1,117 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"bytes"
+	"encoding/csv"
+	"sort"
+	"strings"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+// ResponsesCSV renders form responses as CSV, one row per response. After the
+// response ID, submission time and respondent email, there is a column per
+// question in form order (grid rows as "Title [Row]"), followed by columns
+// for answered questions that are no longer in the form, titled by question ID.
+func ResponsesCSV(form *forms.Form, responses []*forms.FormResponse) ([]byte, error) {
+	header := []string{"Response ID", "Last Submitted", "Email"}
+	var questionIDs []string
+	known := map[string]bool{}
+	if form != nil {
+		for _, it := range form.Items {
+			for _, q := range questionColumns(it) {
+				header = append(header, q[1])
+				questionIDs = append(questionIDs, q[0])
+				known[q[0]] = true
+			}
+		}
+	}
+
+	var extra []string
+	for _, resp := range responses {
+		if resp == nil {
+			continue
+		}
+		for qid := range resp.Answers {
+			if !known[qid] {
+				known[qid] = true
+				extra = append(extra, qid)
+			}
+		}
+	}
+	sort.Strings(extra)
+	header = append(header, extra...)
+	questionIDs = append(questionIDs, extra...)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	for _, resp := range responses {
+		if resp == nil {
+			continue
+		}
+		row := []string{resp.ResponseId, resp.LastSubmittedTime, resp.RespondentEmail}
+		for _, qid := range questionIDs {
+			row = append(row, answerText(resp.Answers[qid]))
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// questionColumns returns the question ID and column title of each question
+// of an item.
+func questionColumns(item *forms.Item) [][2]string {
+	if item == nil {
+		return nil
+	}
+	var cols [][2]string
+	if item.QuestionItem != nil && item.QuestionItem.Question != nil && item.QuestionItem.Question.QuestionId != "" {
+		cols = append(cols, [2]string{item.QuestionItem.Question.QuestionId, item.Title})
+	}
+	if item.QuestionGroupItem != nil {
+		for _, q := range item.QuestionGroupItem.Questions {
+			if q == nil || q.QuestionId == "" {
+				continue
+			}
+			title := item.Title
+			if q.RowQuestion != nil && q.RowQuestion.Title != "" {
+				title += " [" + q.RowQuestion.Title + "]"
+			}
+			cols = append(cols, [2]string{q.QuestionId, title})
+		}
+	}
+	return cols
+}
+
+// answerText renders an answer as a single CSV cell; several values are
+// joined with ", ".
+func answerText(a forms.Answer) string {
+	var values []string
+	if a.TextAnswers != nil {
+		for _, t := range a.TextAnswers.Answers {
+			if t != nil {
+				values = append(values, t.Value)
+			}
+		}
+	}
+	if a.FileUploadAnswers != nil {
+		for _, f := range a.FileUploadAnswers.Answers {
+			if f != nil {
+				values = append(values, f.FileName)
+			}
+		}
+	}
+	return strings.Join(values, ", ")
+}
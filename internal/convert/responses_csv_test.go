@@ -0,0 +1,58 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+func TestResponsesCSV_QuestionColumnsInFormOrder(t *testing.T) {
+	t.Parallel()
+
+	name := liveTextItem("a", "Name")
+	name.QuestionItem.Question.QuestionId = "q_name"
+	grid := &forms.Item{
+		ItemId: "b",
+		Title:  "Rate",
+		QuestionGroupItem: &forms.QuestionGroupItem{
+			Questions: []*forms.Question{
+				{QuestionId: "q_food", RowQuestion: &forms.RowQuestion{Title: "Food"}},
+				{QuestionId: "q_music", RowQuestion: &forms.RowQuestion{Title: "Music"}},
+			},
+		},
+	}
+	form := &forms.Form{Items: []*forms.Item{name, grid}}
+
+	text := func(values ...string) forms.Answer {
+		a := forms.Answer{TextAnswers: &forms.TextAnswers{}}
+		for _, v := range values {
+			a.TextAnswers.Answers = append(a.TextAnswers.Answers, &forms.TextAnswer{Value: v})
+		}
+		return a
+	}
+	responses := []*forms.FormResponse{
+		{
+			ResponseId:        "r1",
+			LastSubmittedTime: "2026-01-02T03:04:05Z",
+			RespondentEmail:   "a@example.com",
+			Answers: map[string]forms.Answer{
+				"q_name":  text("Ada, L."),
+				"q_music": text("Good"),
+				"q_gone":  text("x", "y"),
+			},
+		},
+	}
+
+	got, err := ResponsesCSV(form, responses)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "Response ID,Last Submitted,Email,Name,Rate [Food],Rate [Music],q_gone\n" +
+		"r1,2026-01-02T03:04:05Z,a@example.com,\"Ada, L.\",,Good,\"x, y\"\n"
+	if string(got) != want {
+		t.Errorf("ResponsesCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
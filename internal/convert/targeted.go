@@ -0,0 +1,365 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"fmt"
+	"sort"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+// TargetedInput describes the inputs for planning a targeted item update.
+type TargetedInput struct {
+	// Current is the live item list of the form, in order.
+	Current []*forms.Item
+	// Desired is the desired list of managed items, in order. Every item must
+	// have a unique ItemKey.
+	Desired []ItemModel
+	// KeyToID maps item_keys of previously-managed items to their Google item
+	// IDs.
+	KeyToID map[string]string
+	// Managed is the set of Google item IDs under management. Managed items
+	// that are not claimed by a desired item are deleted. In full management
+	// mode (Partial = false) every current item must be managed.
+	Managed map[string]bool
+	// Partial leaves unmanaged items in place (manage_mode = "partial").
+	Partial bool
+	// AppendNew appends new items at the end of the form instead of at their
+	// desired index (partial_new_item_policy = "append").
+	AppendNew bool
+	// AllowTypeReplacement deletes and re-creates, at the same index, existing
+	// items whose type can no longer be updated in place
+	// (allow_item_type_replacement).
+	AllowTypeReplacement bool
+}
+
+// TargetedPlan is the ordered set of requests for a targeted item update.
+// Requests must be sent in the order Deletes, Moves, Updates, Replacements,
+// Creates.
+type TargetedPlan struct {
+	Deletes []*forms.Request
+	Moves   []*forms.Request
+	Updates []*forms.Request
+	// Replacements holds a delete/create request pair for each replaced item.
+	Replacements []*forms.Request
+	// ReplaceKeys holds the item_key of each replaced item, in the order of
+	// the create requests in Replacements.
+	ReplaceKeys []string
+	Creates     []*forms.Request
+	// CreateKeys holds the item_key of each request in Creates.
+	CreateKeys []string
+	// KeyToID maps item_keys of desired items that already exist, and are
+	// not replaced, to their Google item IDs.
+	KeyToID map[string]string
+}
+
+// TargetedPlanError describes why a targeted update could not be planned.
+type TargetedPlanError struct {
+	Summary string
+	Detail  string
+}
+
+func (e *TargetedPlanError) Error() string {
+	return e.Summary + ": " + e.Detail
+}
+
+// PlanTargetedUpdate computes the minimal delete, move, update and create
+// requests that turn the current item list into the desired one while
+// preserving the IDs of existing items.
+func PlanTargetedUpdate(in TargetedInput) (*TargetedPlan, error) {
+	byID := make(map[string]*forms.Item, len(in.Current))
+	currentIndex := make(map[string]int, len(in.Current))
+	currentOrder := make([]string, 0, len(in.Current))
+	for i, it := range in.Current {
+		if it != nil && it.ItemId != "" {
+			byID[it.ItemId] = it
+			currentIndex[it.ItemId] = i
+			currentOrder = append(currentOrder, it.ItemId)
+		}
+	}
+
+	plan := &TargetedPlan{KeyToID: make(map[string]string, len(in.Desired))}
+
+	// Correlate desired items with existing items.
+	claimed := make(map[string]bool, len(in.Desired))
+	desiredExistingIDs := make([]string, 0, len(in.Desired))
+	for _, it := range in.Desired {
+		gid, ok := in.KeyToID[it.ItemKey]
+		if !ok || gid == "" {
+			continue
+		}
+		if _, exists := byID[gid]; exists {
+			plan.KeyToID[it.ItemKey] = gid
+			claimed[gid] = true
+			desiredExistingIDs = append(desiredExistingIDs, gid)
+			continue
+		}
+		if in.Partial {
+			// Previously-managed item was deleted out-of-band; treat as new.
+			continue
+		}
+		return nil, &TargetedPlanError{
+			Summary: "Targeted Update Failed",
+			Detail:  fmt.Sprintf("State tracked item %q (google_item_id=%s) not found in current form. Switch to update_strategy = \"replace_all\".", it.ItemKey, gid),
+		}
+	}
+
+	// In full management mode every existing item must be tracked, otherwise
+	// moves and deletes could not be computed safely.
+	if !in.Partial {
+		for _, id := range currentOrder {
+			if !in.Managed[id] {
+				return nil, &TargetedPlanError{
+					Summary: "Targeted Update Requires Full Item State",
+					Detail:  "One or more existing items are not present in Terraform state (missing google_item_id mapping). Import the form or switch to update_strategy = \"replace_all\".",
+				}
+			}
+		}
+	}
+
+	// Step A: delete managed items that are no longer desired (reverse order).
+	var deleteIndices []int
+	for id := range in.Managed {
+		if claimed[id] {
+			continue
+		}
+		if idx, ok := currentIndex[id]; ok {
+			deleteIndices = append(deleteIndices, idx)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(deleteIndices)))
+	for _, idx := range deleteIndices {
+		plan.Deletes = append(plan.Deletes, &forms.Request{
+			DeleteItem: &forms.DeleteItemRequest{Location: &forms.Location{Index: int64(idx)}},
+		})
+		currentOrder = append(currentOrder[:idx], currentOrder[idx+1:]...)
+	}
+
+	// Step B: reorder existing items (ignoring new items) to match the desired
+	// order with as few moves as possible.
+	var desiredOrder []string
+	if !in.Partial {
+		if len(currentOrder) != len(desiredExistingIDs) {
+			return nil, &TargetedPlanError{
+				Summary: "Targeted Update Structural Mismatch",
+				Detail:  "After applying deletions, the remaining item count does not match the number of existing items in the plan. Switch to update_strategy = \"replace_all\".",
+			}
+		}
+		desiredOrder = desiredExistingIDs
+	} else {
+		// Permute managed items within their existing slots so that unmanaged
+		// items are left in their original order/positions.
+		managedSlots := make([]int, 0, len(desiredExistingIDs))
+		for idx, id := range currentOrder {
+			if claimed[id] {
+				managedSlots = append(managedSlots, idx)
+			}
+		}
+		if len(managedSlots) != len(desiredExistingIDs) {
+			return nil, &TargetedPlanError{
+				Summary: "Targeted Update Failed",
+				Detail:  "Could not compute managed item slots for partial management mode. Switch to update_strategy = \"replace_all\" or set manage_mode = \"all\".",
+			}
+		}
+		desiredOrder = append([]string{}, currentOrder...)
+		for i, slot := range managedSlots {
+			desiredOrder[slot] = desiredExistingIDs[i]
+		}
+	}
+
+	moves, newOrder, err := planMoves(currentOrder, desiredOrder)
+	if err != nil {
+		return nil, err
+	}
+	plan.Moves = moves
+	currentOrder = newOrder
+
+	// Step C: update existing items in-place.
+	for _, it := range in.Desired {
+		gid, ok := plan.KeyToID[it.ItemKey]
+		if !ok {
+			continue
+		}
+		idx := indexOfString(currentOrder, gid)
+		if idx < 0 {
+			return nil, &TargetedPlanError{
+				Summary: "Targeted Update Failed",
+				Detail:  fmt.Sprintf("Could not find item %s in current order for %q", gid, it.ItemKey),
+			}
+		}
+
+		updated, changed, needsReplace, err := ApplyDesiredItem(byID[gid], it)
+		if err != nil {
+			return nil, &TargetedPlanError{Summary: "Targeted Update Failed", Detail: err.Error()}
+		}
+		if needsReplace && in.AllowTypeReplacement {
+			// Delete and re-create the item in its slot; the item count and
+			// the indices of the other items are unchanged.
+			req, err := ItemModelToCreateRequest(it, idx)
+			if err != nil {
+				return nil, &TargetedPlanError{Summary: "Error Building Item Requests", Detail: err.Error()}
+			}
+			plan.Replacements = append(plan.Replacements,
+				&forms.Request{DeleteItem: &forms.DeleteItemRequest{Location: &forms.Location{Index: int64(idx)}}},
+				req,
+			)
+			plan.ReplaceKeys = append(plan.ReplaceKeys, it.ItemKey)
+			continue
+		}
+		if needsReplace {
+			return nil, &TargetedPlanError{
+				Summary: "Targeted Update Requires Replace-All",
+				Detail:  fmt.Sprintf("Item %q requires a structural change (e.g. question type change) and cannot be updated in-place. Set allow_item_type_replacement = true or switch to update_strategy = \"replace_all\".", it.ItemKey),
+			}
+		}
+		if changed {
+			plan.Updates = append(plan.Updates, &forms.Request{
+				UpdateItem: &forms.UpdateItemRequest{
+					Item:       updated,
+					Location:   &forms.Location{Index: int64(idx)},
+					UpdateMask: "*",
+				},
+			})
+		}
+	}
+
+	// Replaced items get new IDs.
+	replaced := make(map[string]bool, len(plan.ReplaceKeys))
+	for _, key := range plan.ReplaceKeys {
+		replaced[key] = true
+		delete(plan.KeyToID, key)
+	}
+
+	// Step D: create new items at their intended indices (in desired order).
+	for i, it := range in.Desired {
+		if replaced[it.ItemKey] {
+			continue
+		}
+		if _, ok := plan.KeyToID[it.ItemKey]; ok {
+			continue
+		}
+		insertIdx := i
+		if in.Partial && in.AppendNew {
+			insertIdx = len(currentOrder)
+		}
+		req, err := ItemModelToCreateRequest(it, insertIdx)
+		if err != nil {
+			return nil, &TargetedPlanError{Summary: "Error Building Item Requests", Detail: err.Error()}
+		}
+		plan.Creates = append(plan.Creates, req)
+		plan.CreateKeys = append(plan.CreateKeys, it.ItemKey)
+		// Simulate the insert so subsequent create indices line up.
+		currentOrder = append(currentOrder[:insertIdx], append([]string{""}, currentOrder[insertIdx:]...)...)
+	}
+
+	return plan, nil
+}
+
+// planMoves returns the moveItem requests that turn current into desired, a
+// permutation of it, together with the resulting order. Items on a longest
+// increasing subsequence of desired positions (by current index) stay in
+// place; every other item is moved directly after its desired predecessor.
+// This yields the minimum number of moves.
+func planMoves(current, desired []string) ([]*forms.Request, []string, error) {
+	pos := make(map[string]int, len(current))
+	for i, id := range current {
+		pos[id] = i
+	}
+	seq := make([]int, len(desired))
+	for i, id := range desired {
+		p, ok := pos[id]
+		if !ok {
+			return nil, nil, &TargetedPlanError{
+				Summary: "Targeted Update Failed To Locate Existing Item",
+				Detail:  fmt.Sprintf("Could not find existing item ID %s in the current form. Switch to update_strategy = \"replace_all\".", id),
+			}
+		}
+		seq[i] = p
+	}
+
+	stable := make(map[string]bool, len(desired))
+	for _, i := range longestIncreasingSubsequence(seq) {
+		stable[desired[i]] = true
+	}
+
+	order := append([]string{}, current...)
+	var moves []*forms.Request
+	for i, id := range desired {
+		if stable[id] {
+			continue
+		}
+		from := indexOfString(order, id)
+		to := 0
+		if i > 0 {
+			// Position of the predecessor once id has been removed.
+			to = indexOfString(order, desired[i-1])
+			if to > from {
+				to--
+			}
+			to++
+		}
+		if from == to {
+			continue
+		}
+		moves = append(moves, &forms.Request{
+			MoveItem: &forms.MoveItemRequest{
+				OriginalLocation: &forms.Location{Index: int64(from)},
+				NewLocation:      &forms.Location{Index: int64(to)},
+			},
+		})
+		order = moveString(order, from, to)
+	}
+	return moves, order, nil
+}
+
+// longestIncreasingSubsequence returns the indices of one longest strictly
+// increasing subsequence of seq, in ascending order.
+func longestIncreasingSubsequence(seq []int) []int {
+	// tails[k] is the index in seq of the smallest tail of an increasing
+	// subsequence of length k+1; prev links each index to its predecessor.
+	tails := make([]int, 0, len(seq))
+	prev := make([]int, len(seq))
+	for i, v := range seq {
+		k := sort.Search(len(tails), func(j int) bool { return seq[tails[j]] >= v })
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	out := make([]int, len(tails))
+	if len(tails) == 0 {
+		return out
+	}
+	for i, k := tails[len(tails)-1], len(tails)-1; k >= 0; i, k = prev[i], k-1 {
+		out[k] = i
+	}
+	return out
+}
+
+// moveString moves s[from] to index to, shifting the elements in between.
+func moveString(s []string, from, to int) []string {
+	v := s[from]
+	s = append(s[:from], s[from+1:]...)
+	out := make([]string, 0, len(s)+1)
+	out = append(out, s[:to]...)
+	out = append(out, v)
+	return append(out, s[to:]...)
+}
+
+func indexOfString(s []string, want string) int {
+	for i := range s {
+		if s[i] == want {
+			return i
+		}
+	}
+	return -1
+}
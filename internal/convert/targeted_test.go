@@ -0,0 +1,363 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+func liveTextItem(id, title string) *forms.Item {
+	return &forms.Item{
+		ItemId: id,
+		Title:  title,
+		QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{
+				QuestionId:   "q-" + id,
+				TextQuestion: &forms.TextQuestion{},
+			},
+		},
+	}
+}
+
+func shortAnswerModel(key, title string) ItemModel {
+	return ItemModel{Title: title, ItemKey: key, ShortAnswer: &ShortAnswerBlock{QuestionText: title}}
+}
+
+func TestPlanTargetedUpdate_DeleteMoveUpdateCreate(t *testing.T) {
+	t.Parallel()
+	in := TargetedInput{
+		Current: []*forms.Item{
+			liveTextItem("a", "A"),
+			liveTextItem("b", "B"),
+			liveTextItem("c", "C"),
+		},
+		Desired: []ItemModel{
+			shortAnswerModel("kc", "C"),
+			shortAnswerModel("ka", "A renamed"),
+			shortAnswerModel("knew", "New"),
+		},
+		KeyToID: map[string]string{"ka": "a", "kb": "b", "kc": "c"},
+		Managed: map[string]bool{"a": true, "b": true, "c": true},
+	}
+
+	plan, err := PlanTargetedUpdate(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(plan.Deletes) != 1 || plan.Deletes[0].DeleteItem.Location.Index != 1 {
+		t.Fatalf("deletes = %+v, want a single delete at index 1", plan.Deletes)
+	}
+	if len(plan.Moves) != 1 {
+		t.Fatalf("moves = %d, want 1", len(plan.Moves))
+	}
+	if len(plan.Updates) != 1 || plan.Updates[0].UpdateItem.Item.Title != "A renamed" {
+		t.Fatalf("updates = %+v, want a single title update", plan.Updates)
+	}
+	if plan.Updates[0].UpdateItem.Location.Index != 1 {
+		t.Errorf("update index = %d, want 1", plan.Updates[0].UpdateItem.Location.Index)
+	}
+	if len(plan.Creates) != 1 || plan.CreateKeys[0] != "knew" {
+		t.Fatalf("creates = %+v keys = %v, want knew", plan.Creates, plan.CreateKeys)
+	}
+	if got := plan.Creates[0].CreateItem.Location.Index; got != 2 {
+		t.Errorf("create index = %d, want 2", got)
+	}
+	if plan.KeyToID["ka"] != "a" || plan.KeyToID["kc"] != "c" {
+		t.Errorf("KeyToID = %v", plan.KeyToID)
+	}
+}
+
+func TestPlanTargetedUpdate_PartialAppendLeavesUnmanagedItems(t *testing.T) {
+	t.Parallel()
+	in := TargetedInput{
+		Current: []*forms.Item{
+			liveTextItem("x", "Unmanaged"),
+			liveTextItem("a", "A"),
+		},
+		Desired: []ItemModel{
+			shortAnswerModel("ka", "A"),
+			shortAnswerModel("knew", "New"),
+		},
+		KeyToID:   map[string]string{"ka": "a"},
+		Managed:   map[string]bool{"a": true},
+		Partial:   true,
+		AppendNew: true,
+	}
+
+	plan, err := PlanTargetedUpdate(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Deletes) != 0 || len(plan.Moves) != 0 || len(plan.Updates) != 0 {
+		t.Fatalf("unexpected deletes/moves/updates: %+v", plan)
+	}
+	if len(plan.Creates) != 1 || plan.Creates[0].CreateItem.Location.Index != 2 {
+		t.Fatalf("creates = %+v, want a single append at index 2", plan.Creates)
+	}
+}
+
+func TestPlanTargetedUpdate_UntrackedItemInFullMode_Error(t *testing.T) {
+	t.Parallel()
+	in := TargetedInput{
+		Current: []*forms.Item{liveTextItem("a", "A"), liveTextItem("x", "X")},
+		Desired: []ItemModel{shortAnswerModel("ka", "A")},
+		KeyToID: map[string]string{"ka": "a"},
+		Managed: map[string]bool{"a": true},
+	}
+
+	_, err := PlanTargetedUpdate(in)
+	var planErr *TargetedPlanError
+	if !errors.As(err, &planErr) {
+		t.Fatalf("expected TargetedPlanError, got %v", err)
+	}
+	if planErr.Summary != "Targeted Update Requires Full Item State" {
+		t.Errorf("summary = %q", planErr.Summary)
+	}
+}
+
+func TestPlanTargetedUpdate_TypeChange_Error(t *testing.T) {
+	t.Parallel()
+	in := TargetedInput{
+		Current: []*forms.Item{liveTextItem("a", "A")},
+		Desired: []ItemModel{{
+			Title:          "A",
+			ItemKey:        "ka",
+			MultipleChoice: &MultipleChoiceBlock{QuestionText: "A", Options: []ChoiceOption{{Value: "x"}}},
+		}},
+		KeyToID: map[string]string{"ka": "a"},
+		Managed: map[string]bool{"a": true},
+	}
+
+	_, err := PlanTargetedUpdate(in)
+	var planErr *TargetedPlanError
+	if !errors.As(err, &planErr) {
+		t.Fatalf("expected TargetedPlanError, got %v", err)
+	}
+	if planErr.Summary != "Targeted Update Requires Replace-All" {
+		t.Errorf("summary = %q", planErr.Summary)
+	}
+}
+
+func TestPlanTargetedUpdate_TypeChange_AllowedReplacesInPlace(t *testing.T) {
+	t.Parallel()
+	in := TargetedInput{
+		Current: []*forms.Item{liveTextItem("a", "A"), liveTextItem("b", "B")},
+		Desired: []ItemModel{
+			shortAnswerModel("ka", "A"),
+			{Title: "B", ItemKey: "kb", Paragraph: &ParagraphBlock{QuestionText: "B"}},
+		},
+		KeyToID:              map[string]string{"ka": "a", "kb": "b"},
+		Managed:              map[string]bool{"a": true, "b": true},
+		AllowTypeReplacement: true,
+	}
+
+	plan, err := PlanTargetedUpdate(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Replacements) != 2 {
+		t.Fatalf("replacements = %d, want a delete/create pair", len(plan.Replacements))
+	}
+	del, create := plan.Replacements[0], plan.Replacements[1]
+	if del.DeleteItem == nil || del.DeleteItem.Location.Index != 1 {
+		t.Errorf("first replacement request should delete index 1: %+v", del)
+	}
+	if create.CreateItem == nil || create.CreateItem.Location.Index != 1 {
+		t.Errorf("second replacement request should create at index 1: %+v", create)
+	}
+	if !create.CreateItem.Item.QuestionItem.Question.TextQuestion.Paragraph {
+		t.Error("re-created item should be a paragraph question")
+	}
+	if len(plan.ReplaceKeys) != 1 || plan.ReplaceKeys[0] != "kb" {
+		t.Errorf("ReplaceKeys = %v, want [kb]", plan.ReplaceKeys)
+	}
+	if _, ok := plan.KeyToID["kb"]; ok {
+		t.Error("replaced item must not keep its old ID")
+	}
+	if len(plan.Creates) != 0 || len(plan.Deletes) != 0 {
+		t.Errorf("unexpected creates/deletes: %+v", plan)
+	}
+}
+
+// randomPermutation returns n item IDs in their current order and a random
+// desired order of the same IDs.
+func randomPermutation(r *rand.Rand, n int) (current, desired []string) {
+	current = make([]string, n)
+	for i := range current {
+		current[i] = fmt.Sprintf("item-%d", i)
+	}
+	desired = make([]string, n)
+	for i, j := range r.Perm(n) {
+		desired[i] = current[j]
+	}
+	return current, desired
+}
+
+// applyMoves replays moveItem requests against order the way the Forms API
+// does: remove at the original index, then insert at the new index.
+func applyMoves(t *testing.T, order []string, moves []*forms.Request) []string {
+	t.Helper()
+	out := append([]string{}, order...)
+	for _, m := range moves {
+		from := int(m.MoveItem.OriginalLocation.Index)
+		to := int(m.MoveItem.NewLocation.Index)
+		if from < 0 || from >= len(out) || to < 0 || to >= len(out) {
+			t.Fatalf("move %d -> %d out of range for %d items", from, to, len(out))
+		}
+		out = moveString(out, from, to)
+	}
+	return out
+}
+
+func TestPlanMoves_Property_ResultMatchesDesiredOrder(t *testing.T) {
+	t.Parallel()
+	prop := func(seed int64, size uint8) bool {
+		r := rand.New(rand.NewSource(seed))
+		current, desired := randomPermutation(r, int(size)%200)
+
+		moves, order, err := planMoves(current, desired)
+		if err != nil {
+			t.Logf("unexpected error: %v", err)
+			return false
+		}
+		return reflect.DeepEqual(order, desired) &&
+			reflect.DeepEqual(applyMoves(t, current, moves), desired)
+	}
+	if err := quick.Check(prop, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPlanMoves_Property_MovesOnlyItemsOutsideStableSubsequence(t *testing.T) {
+	t.Parallel()
+	prop := func(seed int64, size uint8) bool {
+		r := rand.New(rand.NewSource(seed))
+		current, desired := randomPermutation(r, int(size)%200)
+
+		pos := make(map[string]int, len(current))
+		for i, id := range current {
+			pos[id] = i
+		}
+		seq := make([]int, len(desired))
+		for i, id := range desired {
+			seq[i] = pos[id]
+		}
+
+		moves, _, err := planMoves(current, desired)
+		if err != nil {
+			return false
+		}
+		return len(moves) == len(desired)-lisLengthQuadratic(seq)
+	}
+	if err := quick.Check(prop, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+// lisLengthQuadratic is a straightforward O(n^2) reference implementation of
+// the longest increasing subsequence length.
+func lisLengthQuadratic(seq []int) int {
+	best := 0
+	length := make([]int, len(seq))
+	for i := range seq {
+		length[i] = 1
+		for j := 0; j < i; j++ {
+			if seq[j] < seq[i] && length[j]+1 > length[i] {
+				length[i] = length[j] + 1
+			}
+		}
+		if length[i] > best {
+			best = length[i]
+		}
+	}
+	return best
+}
+
+func TestPlanMoves_InsertNearTopOfLargeForm_SingleMove(t *testing.T) {
+	t.Parallel()
+	current := make([]string, 150)
+	for i := range current {
+		current[i] = fmt.Sprintf("item-%d", i)
+	}
+	// Move the last item to the second position.
+	desired := append([]string{current[0], current[149]}, current[1:149]...)
+
+	moves, _, err := planMoves(current, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(moves) != 1 {
+		t.Fatalf("moves = %d, want 1", len(moves))
+	}
+	if m := moves[0].MoveItem; m.OriginalLocation.Index != 149 || m.NewLocation.Index != 1 {
+		t.Errorf("move = %d -> %d, want 149 -> 1", m.OriginalLocation.Index, m.NewLocation.Index)
+	}
+}
+
+func TestPlanTargetedUpdate_Property_FinalOrderMatchesPlan(t *testing.T) {
+	t.Parallel()
+	prop := func(seed int64, size uint8) bool {
+		r := rand.New(rand.NewSource(seed))
+		n := int(size) % 60
+
+		in := TargetedInput{KeyToID: map[string]string{}, Managed: map[string]bool{}}
+		for i := 0; i < n; i++ {
+			id := fmt.Sprintf("id-%d", i)
+			in.Current = append(in.Current, liveTextItem(id, id))
+			in.KeyToID["k-"+id] = id
+			in.Managed[id] = true
+		}
+		// Keep a random subset of the existing items in a random order and
+		// insert new items at random positions.
+		for _, j := range r.Perm(n) {
+			if r.Intn(4) > 0 {
+				id := fmt.Sprintf("id-%d", j)
+				in.Desired = append(in.Desired, shortAnswerModel("k-"+id, id))
+			}
+		}
+		for i := r.Intn(5); i > 0; i-- {
+			at := r.Intn(len(in.Desired) + 1)
+			key := fmt.Sprintf("new-%d", i)
+			in.Desired = append(in.Desired[:at], append([]ItemModel{shortAnswerModel(key, key)}, in.Desired[at:]...)...)
+		}
+
+		plan, err := PlanTargetedUpdate(in)
+		if err != nil {
+			t.Logf("unexpected error: %v", err)
+			return false
+		}
+
+		// Replay the plan against the item keys of the current form.
+		order := make([]string, n)
+		for i := range order {
+			order[i] = fmt.Sprintf("k-id-%d", i)
+		}
+		for _, d := range plan.Deletes {
+			idx := int(d.DeleteItem.Location.Index)
+			order = append(order[:idx], order[idx+1:]...)
+		}
+		order = applyMoves(t, order, plan.Moves)
+		for i, c := range plan.Creates {
+			idx := int(c.CreateItem.Location.Index)
+			order = append(order[:idx], append([]string{plan.CreateKeys[i]}, order[idx:]...)...)
+		}
+
+		want := make([]string, len(in.Desired))
+		for i, it := range in.Desired {
+			want[i] = it.ItemKey
+		}
+		return reflect.DeepEqual(order, want)
+	}
+	if err := quick.Check(prop, &quick.Config{MaxCount: 300}); err != nil {
+		t.Error(err)
+	}
+}
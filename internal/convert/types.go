@@ -27,6 +27,7 @@ type ItemModel struct {
 	Image              *ImageBlock
 	Video              *VideoBlock
 	SectionHeader      *SectionHeaderBlock
+	RawJSON            *RawJSONBlock
 }
 
 // ChoiceOption describes a single selectable option in a choice question.
@@ -190,11 +191,60 @@ type SectionHeaderBlock struct {
 }
 
 // GradingBlock describes quiz grading settings for a question.
+//
+// CorrectAnswer and CorrectAnswers are combined into a single answer key;
+// CorrectAnswers is used for checkbox questions (several correct options) and
+// for text questions that accept several answer variants.
 type GradingBlock struct {
 	Points            int64
 	CorrectAnswer     string
+	CorrectAnswers    []string
 	FeedbackCorrect   string
 	FeedbackIncorrect string
+	GeneralFeedback   string
+	FeedbackLinks     []FeedbackLink
+	FeedbackVideos    []FeedbackVideo
+}
+
+// Feedback targets used by FeedbackLink and FeedbackVideo.
+const (
+	FeedbackCorrect   = "correct"
+	FeedbackIncorrect = "incorrect"
+	FeedbackGeneral   = "general"
+)
+
+// FeedbackLink is a supplementary link attached to quiz feedback.
+// AppliesTo is one of FeedbackCorrect, FeedbackIncorrect or FeedbackGeneral.
+type FeedbackLink struct {
+	AppliesTo   string
+	URI         string
+	DisplayText string
+}
+
+// FeedbackVideo is a supplementary YouTube video attached to quiz feedback.
+// AppliesTo is one of FeedbackCorrect, FeedbackIncorrect or FeedbackGeneral.
+type FeedbackVideo struct {
+	AppliesTo   string
+	YoutubeURI  string
+	DisplayText string
+}
+
+// Answers returns the combined answer key (CorrectAnswer followed by
+// CorrectAnswers), skipping empty values.
+func (g *GradingBlock) Answers() []string {
+	if g == nil {
+		return nil
+	}
+	out := make([]string, 0, len(g.CorrectAnswers)+1)
+	if g.CorrectAnswer != "" {
+		out = append(out, g.CorrectAnswer)
+	}
+	for _, a := range g.CorrectAnswers {
+		if a != "" {
+			out = append(out, a)
+		}
+	}
+	return out
 }
 
 // FormModel is the convert-package representation of the full form state.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/45ck/terraform-provider-googleforms/internal/client"
@@ -71,6 +72,63 @@ func (d *FormDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 				Description: "Email collection type (DO_NOT_COLLECT, VERIFIED, RESPONDER_INPUT).",
 			},
+			"publish_settings": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Publish state of the form. Null for forms that predate the publishing model.",
+				Attributes: map[string]schema.Attribute{
+					"published": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the form is published.",
+					},
+					"accepting_responses": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the form is accepting responses.",
+					},
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Form items in form order. Items of types the provider does not model are left out.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Google item ID.",
+						},
+						"item_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "item_key derived from the title, as used by import.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Item type, named like the googleforms_form item block (e.g. multiple_choice, checkbox_grid, section_header).",
+						},
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Item title.",
+						},
+						"question_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Question IDs of the item: one for a question, one per row for a grid, none for other items. Responses refer to questions by these IDs; prefilled links use entry.<N>, where N is the question ID read as a hexadecimal number.",
+						},
+						"rows": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Row titles of a grid, in the order of question_ids.",
+						},
+						"options": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Option values of a choice question, or the columns of a grid.",
+						},
+						"section_item_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Item ID of the section header (page break) that starts the item's section. Null for items in the first section.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -126,5 +184,14 @@ func (d *FormDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		data.EmailCollectionType = types.StringNull()
 	}
 
+	var diags diag.Diagnostics
+	data.PublishSettings, diags = publishSettingsValue(f)
+	resp.Diagnostics.Append(diags...)
+	data.Items, diags = itemsValue(f)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
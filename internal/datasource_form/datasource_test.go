@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	forms "google.golang.org/api/forms/v1"
 
@@ -120,3 +121,86 @@ func TestFormDataSource_Read_SetsState(t *testing.T) {
 		t.Fatalf("unexpected diagnostics: %s", readResp.Diagnostics)
 	}
 }
+
+func TestFormDataSource_Read_Items(t *testing.T) {
+	t.Parallel()
+
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			return &forms.Form{
+				FormId: formID,
+				Info:   &forms.Info{Title: "T"},
+				PublishSettings: &forms.PublishSettings{
+					PublishState: &forms.PublishState{IsPublished: true},
+				},
+				Items: []*forms.Item{
+					{
+						ItemId: "i1",
+						Title:  "Favorite color?",
+						QuestionItem: &forms.QuestionItem{Question: &forms.Question{
+							QuestionId: "q1",
+							ChoiceQuestion: &forms.ChoiceQuestion{
+								Type:    "RADIO",
+								Options: []*forms.Option{{Value: "Red"}, {Value: "Blue"}},
+							},
+						}},
+					},
+					{ItemId: "p1", Title: "Part 2", PageBreakItem: &forms.PageBreakItem{}},
+					{
+						ItemId: "i2",
+						Title:  "Rate",
+						QuestionGroupItem: &forms.QuestionGroupItem{
+							Grid: &forms.Grid{Columns: &forms.ChoiceQuestion{
+								Type:    "RADIO",
+								Options: []*forms.Option{{Value: "Bad"}, {Value: "Good"}},
+							}},
+							Questions: []*forms.Question{
+								{QuestionId: "q2", RowQuestion: &forms.RowQuestion{Title: "Food"}},
+								{QuestionId: "q3", RowQuestion: &forms.RowQuestion{Title: "Music"}},
+							},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	ds := &FormDataSource{client: &client.Client{Forms: mockForms}}
+	schemaResp := testSchema(t, ds)
+	cfg := buildConfig(t, schemaResp, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "fid"),
+	})
+
+	readResp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: cfg}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", readResp.Diagnostics)
+	}
+
+	var data FormDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(context.Background(), &data)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", readResp.Diagnostics)
+	}
+	if got := data.PublishSettings.Attributes()["published"].String(); got != "true" {
+		t.Errorf("publish_settings.published = %s, want true", got)
+	}
+
+	items := data.Items.Elements()
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+	want := []map[string]string{
+		{"item_key": `"favorite_color"`, "type": `"multiple_choice"`, "question_ids": `["q1"]`, "options": `["Red","Blue"]`, "section_item_id": "<null>"},
+		{"type": `"section_header"`, "question_ids": `[]`, "section_item_id": `"p1"`},
+		{"type": `"multiple_choice_grid"`, "question_ids": `["q2","q3"]`, "rows": `["Food","Music"]`, "options": `["Bad","Good"]`, "section_item_id": `"p1"`},
+	}
+	for i, w := range want {
+		attrs := items[i].(types.Object).Attributes()
+		for k, v := range w {
+			if got := attrs[k].String(); got != v {
+				t.Errorf("items[%d].%s = %s, want %s", i, k, got, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package datasourceform
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// itemAttrTypes are the attribute types of an element of items.
+var itemAttrTypes = map[string]attr.Type{
+	"item_id":         types.StringType,
+	"item_key":        types.StringType,
+	"type":            types.StringType,
+	"title":           types.StringType,
+	"question_ids":    types.ListType{ElemType: types.StringType},
+	"rows":            types.ListType{ElemType: types.StringType},
+	"options":         types.ListType{ElemType: types.StringType},
+	"section_item_id": types.StringType,
+}
+
+// publishSettingsAttrTypes are the attribute types of publish_settings.
+var publishSettingsAttrTypes = map[string]attr.Type{
+	"published":           types.BoolType,
+	"accepting_responses": types.BoolType,
+}
+
+// itemsValue converts the items of a form to the items attribute. Item keys
+// are derived from the titles the way import does; items of types the
+// provider does not model are left out.
+func itemsValue(f *forms.Form) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objType := types.ObjectType{AttrTypes: itemAttrTypes}
+
+	model, err := convert.FormToModelWithOptions(f, nil, convert.FormToModelOptions{SlugKeys: true})
+	if err != nil {
+		diags.AddError("Read Form Failed", "Could not convert form items: "+err.Error())
+		return types.ListNull(objType), diags
+	}
+
+	apiItems := map[string]*forms.Item{}
+	sections := map[string]string{}
+	section := ""
+	for _, it := range f.Items {
+		if it == nil {
+			continue
+		}
+		if it.PageBreakItem != nil {
+			section = it.ItemId
+		}
+		apiItems[it.ItemId] = it
+		sections[it.ItemId] = section
+	}
+
+	elems := make([]attr.Value, 0, len(model.Items))
+	for _, item := range model.Items {
+		questionIDs, rows := questionIDsAndRows(apiItems[item.GoogleItemID])
+		sectionID := types.StringNull()
+		if s := sections[item.GoogleItemID]; s != "" {
+			sectionID = types.StringValue(s)
+		}
+		obj, d := types.ObjectValue(itemAttrTypes, map[string]attr.Value{
+			"item_id":         types.StringValue(item.GoogleItemID),
+			"item_key":        types.StringValue(item.ItemKey),
+			"type":            types.StringValue(convert.ItemType(item)),
+			"title":           types.StringValue(item.Title),
+			"question_ids":    stringList(questionIDs),
+			"rows":            stringList(rows),
+			"options":         stringList(itemOptions(item)),
+			"section_item_id": sectionID,
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(objType, elems)
+	diags.Append(d...)
+	return list, diags
+}
+
+// questionIDsAndRows returns the question IDs of an item and, for grids, the
+// row titles in the same order.
+func questionIDsAndRows(item *forms.Item) ([]string, []string) {
+	if item == nil {
+		return nil, nil
+	}
+	var ids, rows []string
+	if item.QuestionItem != nil && item.QuestionItem.Question != nil {
+		ids = append(ids, item.QuestionItem.Question.QuestionId)
+	}
+	if item.QuestionGroupItem != nil {
+		for _, q := range item.QuestionGroupItem.Questions {
+			if q == nil {
+				continue
+			}
+			ids = append(ids, q.QuestionId)
+			if q.RowQuestion != nil {
+				rows = append(rows, q.RowQuestion.Title)
+			} else {
+				rows = append(rows, "")
+			}
+		}
+	}
+	return ids, rows
+}
+
+// itemOptions returns the choice options of an item, or the columns of a
+// grid.
+func itemOptions(item convert.ItemModel) []string {
+	var opts []convert.ChoiceOption
+	switch {
+	case item.MultipleChoice != nil:
+		opts = item.MultipleChoice.Options
+	case item.Dropdown != nil:
+		opts = item.Dropdown.Options
+	case item.Checkbox != nil:
+		opts = item.Checkbox.Options
+	case item.MultipleChoiceGrid != nil:
+		return item.MultipleChoiceGrid.Columns
+	case item.CheckboxGrid != nil:
+		return item.CheckboxGrid.Columns
+	default:
+		return nil
+	}
+	values := make([]string, 0, len(opts))
+	for _, o := range opts {
+		values = append(values, o.Value)
+	}
+	return values
+}
+
+// publishSettingsValue converts the publish state of a form; it is null for
+// forms that predate the publishing model.
+func publishSettingsValue(f *forms.Form) (types.Object, diag.Diagnostics) {
+	if f.PublishSettings == nil || f.PublishSettings.PublishState == nil {
+		return types.ObjectNull(publishSettingsAttrTypes), nil
+	}
+	return types.ObjectValue(publishSettingsAttrTypes, map[string]attr.Value{
+		"published":           types.BoolValue(f.PublishSettings.PublishState.IsPublished),
+		"accepting_responses": types.BoolValue(f.PublishSettings.PublishState.IsAcceptingResponses),
+	})
+}
+
+// stringList converts a string slice to a list value; nil becomes an empty
+// list.
+func stringList(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
@@ -19,4 +19,7 @@ type FormDataSourceModel struct {
 	LinkedSheetID       types.String `tfsdk:"linked_sheet_id"`
 	Quiz                types.Bool   `tfsdk:"quiz"`
 	EmailCollectionType types.String `tfsdk:"email_collection_type"`
+
+	PublishSettings types.Object `tfsdk:"publish_settings"`
+	Items           types.List   `tfsdk:"items"`
 }
@@ -0,0 +1,115 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package datasourceprefilledurl
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+)
+
+var _ datasource.DataSource = &PrefilledURLDataSource{}
+
+type PrefilledURLDataSource struct {
+	client *client.Client
+}
+
+func NewPrefilledURLDataSource() datasource.DataSource {
+	return &PrefilledURLDataSource{}
+}
+
+func (d *PrefilledURLDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prefilled_url"
+}
+
+func (d *PrefilledURLDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds a prefilled responder URL for a Google Form. Answers are validated against the form's questions and options.",
+		Attributes: map[string]schema.Attribute{
+			"form_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Form ID.",
+			},
+			"item_ids": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Maps item_keys to Google item IDs, e.g. { for i in googleforms_form.x.item : i.item_key => i.google_item_id }. Items not listed are keyed by their title, as in the items of the googleforms_form data source.",
+			},
+			"answers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "Answers by item_key. Checkbox questions take several answers, other questions one. Choice answers must be options, unless the question has an \"Other\" option, which takes one answer that is not an option. Dates are YYYY-MM-DD (YYYY-MM-DD HH:MM with time), times HH:MM (HH:MM:SS for durations).",
+			},
+			"grid_answers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+				Description: "Grid answers by item_key, as a map of row title to the chosen columns. Checkbox grid rows take several columns, multiple choice grid rows one.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Responder URL with usp=pp_url and an entry.<N> parameter per answer.",
+			},
+		},
+	}
+}
+
+func (d *PrefilledURLDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", "Expected *client.Client, got unexpected type.")
+		return
+	}
+	d.client = c
+}
+
+func (d *PrefilledURLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PrefilledURLDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemIDs := map[string]string{}
+	answers := map[string][]string{}
+	gridAnswers := map[string]map[string][]string{}
+	if !data.ItemIDs.IsNull() {
+		resp.Diagnostics.Append(data.ItemIDs.ElementsAs(ctx, &itemIDs, false)...)
+	}
+	if !data.Answers.IsNull() {
+		resp.Diagnostics.Append(data.Answers.ElementsAs(ctx, &answers, false)...)
+	}
+	if !data.GridAnswers.IsNull() {
+		resp.Diagnostics.Append(data.GridAnswers.ElementsAs(ctx, &gridAnswers, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := d.client.Forms.Get(ctx, data.FormID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read Form Failed", err.Error())
+		return
+	}
+
+	keyMap := make(map[string]string, len(itemIDs))
+	for key, id := range itemIDs {
+		keyMap[id] = key
+	}
+	u, err := prefilledURL(f, keyMap, answers, gridAnswers)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Prefilled Answers", err.Error())
+		return
+	}
+
+	data.URL = types.StringValue(u)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
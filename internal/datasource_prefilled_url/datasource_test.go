@@ -0,0 +1,145 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package datasourceprefilledurl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func prefillForm() *forms.Form {
+	choice := func(id, qid, title, typ string, other bool, values ...string) *forms.Item {
+		cq := &forms.ChoiceQuestion{Type: typ}
+		for _, v := range values {
+			cq.Options = append(cq.Options, &forms.Option{Value: v})
+		}
+		if other {
+			cq.Options = append(cq.Options, &forms.Option{IsOther: true})
+		}
+		return &forms.Item{ItemId: id, Title: title, QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{QuestionId: qid, ChoiceQuestion: cq},
+		}}
+	}
+	return &forms.Form{
+		FormId:       "fid",
+		ResponderUri: "https://docs.google.com/forms/d/e/abc/viewform",
+		Items: []*forms.Item{
+			{ItemId: "i1", Title: "Your name", QuestionItem: &forms.QuestionItem{
+				Question: &forms.Question{QuestionId: "0000000a", TextQuestion: &forms.TextQuestion{}},
+			}},
+			choice("i2", "0000000b", "Color", "RADIO", true, "Red", "Blue"),
+			choice("i3", "0000000c", "Toppings", "CHECKBOX", false, "Cheese", "Ham & Egg"),
+			{ItemId: "i4", Title: "Rate", QuestionGroupItem: &forms.QuestionGroupItem{
+				Grid: &forms.Grid{Columns: &forms.ChoiceQuestion{
+					Type:    "CHECKBOX",
+					Options: []*forms.Option{{Value: "Bad"}, {Value: "Good"}},
+				}},
+				Questions: []*forms.Question{
+					{QuestionId: "0000000d", RowQuestion: &forms.RowQuestion{Title: "Food"}},
+					{QuestionId: "0000000e", RowQuestion: &forms.RowQuestion{Title: "Music"}},
+				},
+			}},
+		},
+	}
+}
+
+func TestPrefilledURL_EncodesAnswersInFormOrder(t *testing.T) {
+	t.Parallel()
+
+	got, err := prefilledURL(prefillForm(), map[string]string{"i1": "name"},
+		map[string][]string{
+			"toppings": {"Ham & Egg", "Cheese"},
+			"name":     {"Ada Lovelace"},
+			"color":    {"Green"},
+		},
+		map[string]map[string][]string{
+			"rate": {"Music": {"Good", "Bad"}},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "https://docs.google.com/forms/d/e/abc/viewform?usp=pp_url" +
+		"&entry.10=Ada+Lovelace" +
+		"&entry.11=__other_option__&entry.11.other_option_response=Green" +
+		"&entry.12=Ham+%26+Egg&entry.12=Cheese" +
+		"&entry.14=Good&entry.14=Bad"
+	if got != want {
+		t.Errorf("prefilledURL() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPrefilledURL_InvalidAnswers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		answers map[string][]string
+		grid    map[string]map[string][]string
+		want    string
+	}{
+		{name: "unknown key", answers: map[string][]string{"nope": {"x"}}, want: `"nope": no item with this item_key`},
+		{name: "not an option", answers: map[string][]string{"toppings": {"Olives"}}, want: `"Olives" is not an option`},
+		{name: "radio takes one", answers: map[string][]string{"color": {"Red", "Blue"}}, want: "takes one answer, got 2"},
+		{name: "grid in answers", answers: map[string][]string{"rate": {"Good"}}, want: "is a grid"},
+		{name: "unknown row", grid: map[string]map[string][]string{"rate": {"Art": {"Good"}}}, want: `no row titled "Art"`},
+		{name: "unknown column", grid: map[string]map[string][]string{"rate": {"Food": {"Great"}}}, want: `"Great" is not a column`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := prefilledURL(prefillForm(), nil, tc.answers, tc.grid)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestPrefilledURLDataSource_Read_SetsURL(t *testing.T) {
+	t.Parallel()
+
+	ds := &PrefilledURLDataSource{client: &client.Client{Forms: &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, _ string) (*forms.Form, error) {
+			return prefillForm(), nil
+		},
+	}}}
+
+	var schemaResp datasource.SchemaResponse
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	objType, ok := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected an object schema type")
+	}
+	answersType := objType.AttributeTypes["answers"].(tftypes.Map)
+	cfg := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+		"form_id":  tftypes.NewValue(tftypes.String, "fid"),
+		"item_ids": tftypes.NewValue(objType.AttributeTypes["item_ids"], nil),
+		"answers": tftypes.NewValue(answersType, map[string]tftypes.Value{
+			"color": tftypes.NewValue(answersType.ElementType, []tftypes.Value{tftypes.NewValue(tftypes.String, "Blue")}),
+		}),
+		"grid_answers": tftypes.NewValue(objType.AttributeTypes["grid_answers"], nil),
+		"url":          tftypes.NewValue(tftypes.String, nil),
+	})}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: cfg}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+
+	var data PrefilledURLDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if want := "https://docs.google.com/forms/d/e/abc/viewform?usp=pp_url&entry.11=Blue"; data.URL.ValueString() != want {
+		t.Errorf("url = %q, want %q", data.URL.ValueString(), want)
+	}
+}
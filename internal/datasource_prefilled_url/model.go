@@ -0,0 +1,16 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package datasourceprefilledurl
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type PrefilledURLDataSourceModel struct {
+	FormID types.String `tfsdk:"form_id"`
+
+	ItemIDs     types.Map `tfsdk:"item_ids"`
+	Answers     types.Map `tfsdk:"answers"`
+	GridAnswers types.Map `tfsdk:"grid_answers"`
+
+	URL types.String `tfsdk:"url"`
+}
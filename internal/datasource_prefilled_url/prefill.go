@@ -0,0 +1,292 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package datasourceprefilledurl
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// otherOptionValue is the entry value that selects the "Other" option.
+const otherOptionValue = "__other_option__"
+
+// prefilledURL builds the prefilled responder URL of a form. keyMap maps
+// Google item IDs to item_keys; other items are keyed by their title like the
+// googleforms_form data source. answers holds the answers of questions and
+// gridAnswers the columns chosen per row title of grids, both by item_key.
+func prefilledURL(
+	f *forms.Form,
+	keyMap map[string]string,
+	answers map[string][]string,
+	gridAnswers map[string]map[string][]string,
+) (string, error) {
+	if f.ResponderUri == "" {
+		return "", fmt.Errorf("form %s has no responder URI", f.FormId)
+	}
+
+	model, err := convert.FormToModelWithOptions(f, keyMap, convert.FormToModelOptions{SlugKeys: true})
+	if err != nil {
+		return "", err
+	}
+	keys := map[string]string{}
+	for _, item := range model.Items {
+		keys[item.GoogleItemID] = item.ItemKey
+	}
+
+	var errs []error
+	used := map[string]bool{}
+	params := []string{"usp=pp_url"}
+	for _, item := range f.Items {
+		if item == nil {
+			continue
+		}
+		key, ok := keys[item.ItemId]
+		if !ok {
+			continue
+		}
+		values, hasAnswer := answers[key]
+		rows, hasGrid := gridAnswers[key]
+		if !hasAnswer && !hasGrid {
+			continue
+		}
+		used[key] = true
+
+		var p []string
+		switch {
+		case item.QuestionItem != nil && item.QuestionItem.Question != nil && !hasGrid:
+			p, err = questionParams(item.QuestionItem.Question, values)
+		case item.QuestionGroupItem != nil && !hasAnswer:
+			p, err = gridParams(item.QuestionGroupItem, rows)
+		case item.QuestionItem != nil:
+			err = errors.New("is not a grid; set its answers in answers")
+		case item.QuestionGroupItem != nil:
+			err = errors.New("is a grid; set its answers in grid_answers")
+		default:
+			err = errors.New("is not a question")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("item %q: %w", key, err))
+			continue
+		}
+		params = append(params, p...)
+	}
+
+	for _, key := range unusedKeys(answers, gridAnswers, used) {
+		errs = append(errs, fmt.Errorf("item %q: no item with this item_key", key))
+	}
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	sep := "?"
+	if strings.Contains(f.ResponderUri, "?") {
+		sep = "&"
+	}
+	return f.ResponderUri + sep + strings.Join(params, "&"), nil
+}
+
+// questionParams validates the answers to a question and returns its query
+// parameters.
+func questionParams(q *forms.Question, values []string) ([]string, error) {
+	entry, err := entryName(q.QuestionId)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, errors.New("no answers")
+	}
+	single := func() error {
+		if len(values) != 1 {
+			return fmt.Errorf("takes one answer, got %d", len(values))
+		}
+		return nil
+	}
+
+	switch {
+	case q.ChoiceQuestion != nil:
+		return choiceParams(entry, q.ChoiceQuestion, values)
+	case q.ScaleQuestion != nil:
+		if err := single(); err != nil {
+			return nil, err
+		}
+		if err := checkRange(values[0], q.ScaleQuestion.Low, q.ScaleQuestion.High); err != nil {
+			return nil, err
+		}
+	case q.RatingQuestion != nil:
+		if err := single(); err != nil {
+			return nil, err
+		}
+		if err := checkRange(values[0], 1, q.RatingQuestion.RatingScaleLevel); err != nil {
+			return nil, err
+		}
+	case q.DateQuestion != nil:
+		if err := single(); err != nil {
+			return nil, err
+		}
+		layout := "2006-01-02"
+		if q.DateQuestion.IncludeTime {
+			layout = "2006-01-02 15:04"
+		}
+		if _, err := time.Parse(layout, values[0]); err != nil {
+			return nil, fmt.Errorf("%q is not a date in the format %s", values[0], layout)
+		}
+	case q.TimeQuestion != nil:
+		if err := single(); err != nil {
+			return nil, err
+		}
+		layout := "15:04"
+		if q.TimeQuestion.Duration {
+			layout = "15:04:05"
+		}
+		if _, err := time.Parse(layout, values[0]); err != nil {
+			return nil, fmt.Errorf("%q is not a time in the format %s", values[0], layout)
+		}
+	case q.FileUploadQuestion != nil:
+		return nil, errors.New("file upload questions cannot be prefilled")
+	default:
+		if err := single(); err != nil {
+			return nil, err
+		}
+	}
+	return []string{param(entry, values[0])}, nil
+}
+
+// choiceParams validates the answers to a choice question against its
+// options. Answers that are not options fill in the "Other" option when the
+// question has one.
+func choiceParams(entry string, cq *forms.ChoiceQuestion, values []string) ([]string, error) {
+	if cq.Type != "CHECKBOX" && len(values) != 1 {
+		return nil, fmt.Errorf("takes one answer, got %d", len(values))
+	}
+	options := map[string]bool{}
+	var names []string
+	hasOther := false
+	for _, o := range cq.Options {
+		if o == nil {
+			continue
+		}
+		if o.IsOther {
+			hasOther = true
+			continue
+		}
+		options[o.Value] = true
+		names = append(names, strconv.Quote(o.Value))
+	}
+
+	var params []string
+	other := ""
+	for _, v := range values {
+		switch {
+		case options[v]:
+			params = append(params, param(entry, v))
+		case hasOther && other == "":
+			other = v
+		case hasOther:
+			return nil, fmt.Errorf("%q and %q are not options, and only one answer can use the \"Other\" option", other, v)
+		default:
+			return nil, fmt.Errorf("%q is not an option; options are %s", v, strings.Join(names, ", "))
+		}
+	}
+	if other != "" {
+		params = append(params, param(entry, otherOptionValue), param(entry+".other_option_response", other))
+	}
+	return params, nil
+}
+
+// gridParams validates the columns chosen per row of a grid and returns the
+// query parameters, in row order.
+func gridParams(group *forms.QuestionGroupItem, rows map[string][]string) ([]string, error) {
+	columns := map[string]bool{}
+	checkbox := false
+	if group.Grid != nil && group.Grid.Columns != nil {
+		checkbox = group.Grid.Columns.Type == "CHECKBOX"
+		for _, o := range group.Grid.Columns.Options {
+			if o != nil {
+				columns[o.Value] = true
+			}
+		}
+	}
+
+	var params []string
+	seen := map[string]bool{}
+	for _, q := range group.Questions {
+		if q == nil || q.RowQuestion == nil {
+			continue
+		}
+		row := q.RowQuestion.Title
+		values, ok := rows[row]
+		if !ok {
+			continue
+		}
+		seen[row] = true
+		entry, err := entryName(q.QuestionId)
+		if err != nil {
+			return nil, err
+		}
+		if !checkbox && len(values) > 1 {
+			return nil, fmt.Errorf("row %q takes one answer, got %d", row, len(values))
+		}
+		for _, v := range values {
+			if !columns[v] {
+				return nil, fmt.Errorf("row %q: %q is not a column", row, v)
+			}
+			params = append(params, param(entry, v))
+		}
+	}
+	for row := range rows {
+		if !seen[row] {
+			return nil, fmt.Errorf("no row titled %q", row)
+		}
+	}
+	return params, nil
+}
+
+// entryName returns the entry.N parameter name of a question; N is the
+// question ID read as a hexadecimal number.
+func entryName(questionID string) (string, error) {
+	n, err := strconv.ParseUint(questionID, 16, 64)
+	if err != nil {
+		return "", fmt.Errorf("question ID %q is not hexadecimal", questionID)
+	}
+	return "entry." + strconv.FormatUint(n, 10), nil
+}
+
+// checkRange checks that v is an integer between low and high.
+func checkRange(v string, low, high int64) error {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < low || n > high {
+		return fmt.Errorf("%q is not an integer between %d and %d", v, low, high)
+	}
+	return nil
+}
+
+func param(name, value string) string {
+	return name + "=" + url.QueryEscape(value)
+}
+
+// unusedKeys returns the sorted answer keys that did not match an item.
+func unusedKeys(answers map[string][]string, gridAnswers map[string]map[string][]string, used map[string]bool) []string {
+	var out []string
+	for k := range answers {
+		if !used[k] {
+			out = append(out, k)
+		}
+	}
+	for k := range gridAnswers {
+		if _, dup := answers[k]; !used[k] && !dup {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,381 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hclgen renders an existing Google Form as a googleforms_form
+// resource in HCL, together with the import block that adopts it.
+package hclgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// Options tunes the generated configuration.
+type Options struct {
+	// ResourceName is the Terraform resource name. Defaults to "form".
+	ResourceName string
+
+	// SlugKeys derives item_keys from question titles instead of positions.
+	SlugKeys bool
+}
+
+// Generate renders form as HCL. The import block's ID maps every generated
+// item_key to its item ID, so the imported state matches the configuration.
+func Generate(form *forms.Form, opts Options) (string, error) {
+	if form == nil || form.FormId == "" {
+		return "", fmt.Errorf("form has no ID")
+	}
+	name := opts.ResourceName
+	if name == "" {
+		name = "form"
+	}
+
+	// Give every item a key up front so that section navigation is rendered
+	// as go_to_section_key.
+	keyMap := map[string]string{}
+	if !opts.SlugKeys {
+		for i, item := range form.Items {
+			if item != nil && item.ItemId != "" {
+				keyMap[item.ItemId] = fmt.Sprintf("item_%d", i)
+			}
+		}
+	}
+	model, err := convert.FormToModelWithOptions(form, keyMap, convert.FormToModelOptions{SlugKeys: opts.SlugKeys})
+	if err != nil {
+		return "", err
+	}
+
+	res := &block{header: fmt.Sprintf("resource \"googleforms_form\" %s", quote(name))}
+	res.attr("title", quote(model.Title))
+	res.attrIf(model.Description != "", "description", quote(model.Description))
+	res.attrIf(model.Quiz, "quiz", "true")
+	res.attrIf(model.EmailCollectionType != "" && model.EmailCollectionType != "DO_NOT_COLLECT",
+		"email_collection_type", quote(model.EmailCollectionType))
+	if ps := form.PublishSettings; ps != nil && ps.PublishState != nil {
+		res.attr("published", strconv.FormatBool(ps.PublishState.IsPublished))
+		res.attr("accepting_responses", strconv.FormatBool(ps.PublishState.IsAcceptingResponses))
+	}
+
+	converted := map[string]bool{}
+	for _, item := range model.Items {
+		converted[item.GoogleItemID] = true
+		res.blocks = append(res.blocks, itemBlock(item))
+	}
+
+	var b strings.Builder
+	b.WriteString("import {\n")
+	fmt.Fprintf(&b, "  to = googleforms_form.%s\n", name)
+	fmt.Fprintf(&b, "  id = %s\n", quote(importID(form.FormId, model.Items)))
+	b.WriteString("}\n\n")
+	skipped := false
+	for i, item := range form.Items {
+		if item != nil && !converted[item.ItemId] {
+			fmt.Fprintf(&b, "# Item %d (%s, %q) has an unsupported type and was skipped.\n", i, item.ItemId, item.Title)
+			skipped = true
+		}
+	}
+	if skipped {
+		b.WriteString("\n")
+	}
+	res.write(&b, 0)
+	return b.String(), nil
+}
+
+// importID returns FORM_ID#key=ITEM_ID,... for items.
+func importID(formID string, items []convert.ItemModel) string {
+	pairs := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.GoogleItemID != "" {
+			pairs = append(pairs, item.ItemKey+"="+item.GoogleItemID)
+		}
+	}
+	if len(pairs) == 0 {
+		return formID
+	}
+	return formID + "#" + strings.Join(pairs, ",")
+}
+
+// itemBlock renders an item and its question type sub-block.
+func itemBlock(item convert.ItemModel) *block {
+	b := &block{header: "item"}
+	b.attr("item_key", quote(item.ItemKey))
+
+	switch {
+	case item.MultipleChoice != nil:
+		q := item.MultipleChoice
+		sub := b.child("multiple_choice")
+		sub.attr("question_text", quote(q.QuestionText))
+		choiceOptions(sub, q.Options)
+		sub.attrIf(q.Shuffle, "shuffle", "true")
+		sub.attrIf(q.HasOther, "has_other", "true")
+		sub.attrIf(q.Required, "required", "true")
+		gradingBlock(sub, q.Grading)
+	case item.ShortAnswer != nil:
+		q := item.ShortAnswer
+		sub := b.child("short_answer")
+		sub.attr("question_text", quote(q.QuestionText))
+		sub.attrIf(q.Required, "required", "true")
+		gradingBlock(sub, q.Grading)
+	case item.Paragraph != nil:
+		q := item.Paragraph
+		sub := b.child("paragraph")
+		sub.attr("question_text", quote(q.QuestionText))
+		sub.attrIf(q.Required, "required", "true")
+		gradingBlock(sub, q.Grading)
+	case item.Dropdown != nil:
+		q := item.Dropdown
+		sub := b.child("dropdown")
+		sub.attr("question_text", quote(q.QuestionText))
+		choiceOptions(sub, q.Options)
+		sub.attrIf(q.Shuffle, "shuffle", "true")
+		sub.attrIf(q.Required, "required", "true")
+		gradingBlock(sub, q.Grading)
+	case item.Checkbox != nil:
+		q := item.Checkbox
+		sub := b.child("checkbox")
+		sub.attr("question_text", quote(q.QuestionText))
+		choiceOptions(sub, q.Options)
+		sub.attrIf(q.Shuffle, "shuffle", "true")
+		sub.attrIf(q.HasOther, "has_other", "true")
+		sub.attrIf(q.Required, "required", "true")
+		gradingBlock(sub, q.Grading)
+	case item.MultipleChoiceGrid != nil:
+		q := item.MultipleChoiceGrid
+		gridBlock(b.child("multiple_choice_grid"), q.QuestionText, q.Rows, q.Columns, q.Required, q.ShuffleQuestions, q.ShuffleColumns)
+	case item.CheckboxGrid != nil:
+		q := item.CheckboxGrid
+		gridBlock(b.child("checkbox_grid"), q.QuestionText, q.Rows, q.Columns, q.Required, q.ShuffleQuestions, q.ShuffleColumns)
+	case item.Date != nil:
+		sub := b.child("date")
+		sub.attr("question_text", quote(item.Date.QuestionText))
+		sub.attrIf(item.Date.Required, "required", "true")
+		sub.attrIf(item.Date.IncludeYear, "include_year", "true")
+	case item.DateTime != nil:
+		sub := b.child("date_time")
+		sub.attr("question_text", quote(item.DateTime.QuestionText))
+		sub.attrIf(item.DateTime.Required, "required", "true")
+		sub.attrIf(item.DateTime.IncludeYear, "include_year", "true")
+	case item.Scale != nil:
+		q := item.Scale
+		sub := b.child("scale")
+		sub.attr("question_text", quote(q.QuestionText))
+		sub.attrIf(q.Required, "required", "true")
+		sub.attr("low", strconv.FormatInt(q.Low, 10))
+		sub.attr("high", strconv.FormatInt(q.High, 10))
+		sub.attrIf(q.LowLabel != "", "low_label", quote(q.LowLabel))
+		sub.attrIf(q.HighLabel != "", "high_label", quote(q.HighLabel))
+	case item.Time != nil:
+		sub := b.child("time")
+		sub.attr("question_text", quote(item.Time.QuestionText))
+		sub.attrIf(item.Time.Required, "required", "true")
+		sub.attrIf(item.Time.Duration, "duration", "true")
+	case item.Rating != nil:
+		q := item.Rating
+		sub := b.child("rating")
+		sub.attr("question_text", quote(q.QuestionText))
+		sub.attrIf(q.Required, "required", "true")
+		sub.attrIf(q.IconType != "", "icon_type", quote(q.IconType))
+		sub.attrIf(q.RatingScaleLevel != 0, "rating_scale_level", strconv.FormatInt(q.RatingScaleLevel, 10))
+	case item.FileUpload != nil:
+		q := item.FileUpload
+		sub := b.child("file_upload")
+		sub.attr("question_text", quote(q.QuestionText))
+		sub.attrIf(q.Required, "required", "true")
+		sub.attrIf(q.FolderID != "", "folder_id", quote(q.FolderID))
+		sub.attrIf(q.MaxFileSize != 0, "max_file_size", strconv.FormatInt(q.MaxFileSize, 10))
+		sub.attrIf(q.MaxFiles != 0, "max_files", strconv.FormatInt(q.MaxFiles, 10))
+		sub.attrIf(len(q.Types) > 0, "types", list(q.Types))
+	case item.TextItem != nil:
+		sub := b.child("text_item")
+		sub.attr("title", quote(item.TextItem.Title))
+		sub.attrIf(item.TextItem.Description != "", "description", quote(item.TextItem.Description))
+	case item.Image != nil:
+		q := item.Image
+		sub := b.child("image")
+		sub.attrIf(q.Title != "", "title", quote(q.Title))
+		sub.attrIf(q.Description != "", "description", quote(q.Description))
+		// The API does not return the source URI; the temporary content URI
+		// is the closest replacement.
+		src := q.SourceURI
+		if src == "" {
+			src = q.ContentURI
+		}
+		sub.attr("source_uri", quote(src))
+		sub.attrIf(q.AltText != "", "alt_text", quote(q.AltText))
+	case item.Video != nil:
+		q := item.Video
+		sub := b.child("video")
+		sub.attrIf(q.Title != "", "title", quote(q.Title))
+		sub.attrIf(q.Description != "", "description", quote(q.Description))
+		sub.attr("youtube_uri", quote(q.YoutubeURI))
+		sub.attrIf(q.Caption != "", "caption", quote(q.Caption))
+	case item.SectionHeader != nil:
+		sub := b.child("section_header")
+		sub.attr("title", quote(item.SectionHeader.Title))
+		sub.attrIf(item.SectionHeader.Description != "", "description", quote(item.SectionHeader.Description))
+	}
+	return b
+}
+
+// choiceOptions renders options as a list, or as option blocks when any
+// option navigates to another section.
+func choiceOptions(b *block, opts []convert.ChoiceOption) {
+	navigates := false
+	values := make([]string, 0, len(opts))
+	for _, o := range opts {
+		values = append(values, o.Value)
+		if o.GoToAction != "" || o.GoToSectionKey != "" || o.GoToSectionID != "" {
+			navigates = true
+		}
+	}
+	if !navigates {
+		b.attr("options", list(values))
+		return
+	}
+	for _, o := range opts {
+		ob := b.child("option")
+		ob.attr("value", quote(o.Value))
+		ob.attrIf(o.GoToAction != "", "go_to_action", quote(o.GoToAction))
+		switch {
+		case o.GoToSectionKey != "":
+			ob.attr("go_to_section_key", quote(o.GoToSectionKey))
+		case o.GoToSectionID != "":
+			ob.attr("go_to_section_id", quote(o.GoToSectionID))
+		}
+	}
+}
+
+// gridBlock fills a multiple_choice_grid or checkbox_grid block.
+func gridBlock(b *block, text string, rows, cols []string, required, shuffleQuestions, shuffleColumns bool) {
+	b.attr("question_text", quote(text))
+	b.attr("rows", list(rows))
+	b.attr("columns", list(cols))
+	b.attrIf(required, "required", "true")
+	b.attrIf(shuffleQuestions, "shuffle_questions", "true")
+	b.attrIf(shuffleColumns, "shuffle_columns", "true")
+}
+
+// gradingBlock adds a grading block for g, if any.
+func gradingBlock(b *block, g *convert.GradingBlock) {
+	if g == nil {
+		return
+	}
+	gb := b.child("grading")
+	gb.attr("points", strconv.FormatInt(g.Points, 10))
+	answers := g.Answers()
+	switch len(answers) {
+	case 0:
+	case 1:
+		gb.attr("correct_answer", quote(answers[0]))
+	default:
+		gb.attr("correct_answers", list(answers))
+	}
+	gb.attrIf(g.FeedbackCorrect != "", "feedback_correct", quote(g.FeedbackCorrect))
+	gb.attrIf(g.FeedbackIncorrect != "", "feedback_incorrect", quote(g.FeedbackIncorrect))
+	gb.attrIf(g.GeneralFeedback != "", "general_feedback", quote(g.GeneralFeedback))
+	for _, l := range g.FeedbackLinks {
+		lb := gb.child("feedback_link")
+		lb.attr("applies_to", quote(l.AppliesTo))
+		lb.attr("uri", quote(l.URI))
+		lb.attr("display_text", quote(l.DisplayText))
+	}
+	for _, v := range g.FeedbackVideos {
+		vb := gb.child("feedback_video")
+		vb.attr("applies_to", quote(v.AppliesTo))
+		vb.attr("youtube_uri", quote(v.YoutubeURI))
+		vb.attr("display_text", quote(v.DisplayText))
+	}
+}
+
+// block is an HCL block with attributes followed by nested blocks.
+type block struct {
+	header string
+	attrs  [][2]string
+	blocks []*block
+}
+
+func (b *block) attr(name, value string) {
+	b.attrs = append(b.attrs, [2]string{name, value})
+}
+
+func (b *block) attrIf(cond bool, name, value string) {
+	if cond {
+		b.attr(name, value)
+	}
+}
+
+func (b *block) child(header string) *block {
+	c := &block{header: header}
+	b.blocks = append(b.blocks, c)
+	return c
+}
+
+// write renders b the way terraform fmt would: equals signs aligned within
+// the attribute group and a blank line between nested blocks.
+func (b *block) write(w *strings.Builder, depth int) {
+	indent := strings.Repeat("  ", depth)
+	fmt.Fprintf(w, "%s%s {\n", indent, b.header)
+
+	width := 0
+	for _, a := range b.attrs {
+		if len(a[0]) > width {
+			width = len(a[0])
+		}
+	}
+	for _, a := range b.attrs {
+		fmt.Fprintf(w, "%s  %-*s = %s\n", indent, width, a[0], a[1])
+	}
+	for i, c := range b.blocks {
+		if i > 0 || len(b.attrs) > 0 {
+			w.WriteString("\n")
+		}
+		c.write(w, depth+1)
+	}
+	fmt.Fprintf(w, "%s}\n", indent)
+}
+
+// list renders a list of strings.
+func list(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quote(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// hclEscaper escapes template sequences, which HCL would otherwise
+// interpret inside quoted strings.
+var hclEscaper = strings.NewReplacer("${", "$${", "%{", "%%{")
+
+// quote renders s as an HCL quoted string.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range hclEscaper.Replace(s) {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package hclgen
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func loadFixture(t *testing.T, name string) *forms.Form {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+	var form forms.Form
+	if err := json.Unmarshal(data, &form); err != nil {
+		t.Fatalf("parsing fixture: %v", err)
+	}
+	return &form
+}
+
+func TestGenerate_Golden(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		golden  string
+		opts    Options
+	}{
+		{fixture: "survey_form.json", golden: "survey_form.tf", opts: Options{ResourceName: "survey"}},
+		{fixture: "survey_form.json", golden: "survey_form_slug.tf", opts: Options{ResourceName: "survey", SlugKeys: true}},
+		{fixture: "quiz_form.json", golden: "quiz_form.tf", opts: Options{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Generate(loadFixture(t, tt.fixture), tt.opts)
+			if err != nil {
+				t.Fatalf("Generate: %v", err)
+			}
+
+			path := filepath.Join("testdata", tt.golden)
+			if *update {
+				if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+					t.Fatalf("writing golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading golden file: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("generated HCL differs from %s (run with -update to accept):\n%s", path, got)
+			}
+		})
+	}
+}
+
+func TestGenerate_NoFormID(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Generate(&forms.Form{}, Options{}); err == nil {
+		t.Fatal("expected an error for a form without ID")
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	got := quote("say \"hi\"\\ ${x} %{y}\n\x01")
+	want := `"say \"hi\"\\ $${x} %%{y}\n\u0001"`
+	if got != want {
+		t.Fatalf("quote = %s, want %s", got, want)
+	}
+	if strings.Contains(quote("$5 and 100%"), "$$") {
+		t.Fatal("plain dollar and percent signs must not be escaped")
+	}
+}
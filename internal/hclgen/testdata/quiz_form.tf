@@ -0,0 +1,74 @@
+import {
+  to = googleforms_form.form
+  id = "1FAIpQLQuizForm#item_0=aa000001,item_1=aa000002,item_2=aa000003,item_3=aa000004"
+}
+
+# Item 4 (aa000005, "Draw the route") has an unsupported type and was skipped.
+
+resource "googleforms_form" "form" {
+  title = "Geography Quiz"
+  quiz  = true
+
+  item {
+    item_key = "item_0"
+
+    multiple_choice {
+      question_text = "Capital of France?"
+      options       = ["Paris", "Lyon"]
+      shuffle       = true
+      required      = true
+
+      grading {
+        points             = 2
+        correct_answer     = "Paris"
+        feedback_correct   = "Correct!"
+        feedback_incorrect = "Not quite."
+
+        feedback_link {
+          applies_to   = "incorrect"
+          uri          = "https://example.com/france"
+          display_text = "About France"
+        }
+      }
+    }
+  }
+
+  item {
+    item_key = "item_1"
+
+    checkbox {
+      question_text = "Which are in Europe?"
+      options       = ["Spain", "Italy", "Peru"]
+
+      grading {
+        points          = 3
+        correct_answers = ["Spain", "Italy"]
+      }
+    }
+  }
+
+  item {
+    item_key = "item_2"
+
+    paragraph {
+      question_text = "Explain plate tectonics"
+
+      grading {
+        points           = 5
+        general_feedback = "Graded by hand."
+      }
+    }
+  }
+
+  item {
+    item_key = "item_3"
+
+    file_upload {
+      question_text = "Upload your map"
+      folder_id     = "folder-1"
+      max_file_size = 10485760
+      max_files     = 1
+      types         = ["IMAGE"]
+    }
+  }
+}
@@ -0,0 +1,90 @@
+import {
+  to = googleforms_form.survey
+  id = "1FAIpQLSurveyForm#item_0=1a2b3c4d,item_1=2b3c4d5e,item_2=3c4d5e6f,item_3=4d5e6f7a,item_4=5e6f7a8b,item_5=6f7a8b9c,item_6=7a8b9c0d"
+}
+
+resource "googleforms_form" "survey" {
+  title                 = "Team \"Offsite\" Survey"
+  description           = "Tell us what you think.\nAnswers are anonymous."
+  email_collection_type = "VERIFIED"
+  published             = true
+  accepting_responses   = true
+
+  item {
+    item_key = "item_0"
+
+    multiple_choice {
+      question_text = "Are you attending?"
+      required      = true
+
+      option {
+        value             = "Yes"
+        go_to_section_key = "item_1"
+      }
+
+      option {
+        value        = "No"
+        go_to_action = "SUBMIT_FORM"
+      }
+    }
+  }
+
+  item {
+    item_key = "item_1"
+
+    section_header {
+      title       = "Logistics"
+      description = "Only for attendees"
+    }
+  }
+
+  item {
+    item_key = "item_2"
+
+    checkbox {
+      question_text = "Dietary needs"
+      options       = ["Vegetarian", "Vegan"]
+      has_other     = true
+    }
+  }
+
+  item {
+    item_key = "item_3"
+
+    multiple_choice_grid {
+      question_text = "Rate the sessions"
+      rows          = ["Morning", "Afternoon"]
+      columns       = ["Good", "Bad"]
+      required      = true
+    }
+  }
+
+  item {
+    item_key = "item_4"
+
+    scale {
+      question_text = "Overall, how likely are you to come again?"
+      low           = 1
+      high          = 5
+      low_label     = "Unlikely"
+      high_label    = "Very likely"
+    }
+  }
+
+  item {
+    item_key = "item_5"
+
+    date {
+      question_text = "Arrival date"
+      include_year  = true
+    }
+  }
+
+  item {
+    item_key = "item_6"
+
+    paragraph {
+      question_text = "Anything else? Use $${name} if you like"
+    }
+  }
+}
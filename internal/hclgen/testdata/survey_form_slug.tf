@@ -0,0 +1,90 @@
+import {
+  to = googleforms_form.survey
+  id = "1FAIpQLSurveyForm#are_you_attending=1a2b3c4d,logistics=2b3c4d5e,dietary_needs=3c4d5e6f,rate_the_sessions=4d5e6f7a,overall_how_likely_are_you_to_come_again=5e6f7a8b,arrival_date=6f7a8b9c,anything_else_use_name_if_you_like=7a8b9c0d"
+}
+
+resource "googleforms_form" "survey" {
+  title                 = "Team \"Offsite\" Survey"
+  description           = "Tell us what you think.\nAnswers are anonymous."
+  email_collection_type = "VERIFIED"
+  published             = true
+  accepting_responses   = true
+
+  item {
+    item_key = "are_you_attending"
+
+    multiple_choice {
+      question_text = "Are you attending?"
+      required      = true
+
+      option {
+        value             = "Yes"
+        go_to_section_key = "logistics"
+      }
+
+      option {
+        value        = "No"
+        go_to_action = "SUBMIT_FORM"
+      }
+    }
+  }
+
+  item {
+    item_key = "logistics"
+
+    section_header {
+      title       = "Logistics"
+      description = "Only for attendees"
+    }
+  }
+
+  item {
+    item_key = "dietary_needs"
+
+    checkbox {
+      question_text = "Dietary needs"
+      options       = ["Vegetarian", "Vegan"]
+      has_other     = true
+    }
+  }
+
+  item {
+    item_key = "rate_the_sessions"
+
+    multiple_choice_grid {
+      question_text = "Rate the sessions"
+      rows          = ["Morning", "Afternoon"]
+      columns       = ["Good", "Bad"]
+      required      = true
+    }
+  }
+
+  item {
+    item_key = "overall_how_likely_are_you_to_come_again"
+
+    scale {
+      question_text = "Overall, how likely are you to come again?"
+      low           = 1
+      high          = 5
+      low_label     = "Unlikely"
+      high_label    = "Very likely"
+    }
+  }
+
+  item {
+    item_key = "arrival_date"
+
+    date {
+      question_text = "Arrival date"
+      include_year  = true
+    }
+  }
+
+  item {
+    item_key = "anything_else_use_name_if_you_like"
+
+    paragraph {
+      question_text = "Anything else? Use $${name} if you like"
+    }
+  }
+}
@@ -19,12 +19,14 @@ import (
 	"github.com/45ck/terraform-provider-googleforms/internal/client"
 	datasourcedrivefile "github.com/45ck/terraform-provider-googleforms/internal/datasource_drive_file"
 	datasourceform "github.com/45ck/terraform-provider-googleforms/internal/datasource_form"
+	datasourceprefilledurl "github.com/45ck/terraform-provider-googleforms/internal/datasource_prefilled_url"
 	datasourcesheetvalues "github.com/45ck/terraform-provider-googleforms/internal/datasource_sheet_values"
 	datasourcespreadsheet "github.com/45ck/terraform-provider-googleforms/internal/datasource_spreadsheet"
 	resourcedrivefile "github.com/45ck/terraform-provider-googleforms/internal/resource_drive_file"
 	resourcedrivefolder "github.com/45ck/terraform-provider-googleforms/internal/resource_drive_folder"
 	resourcedrivepermission "github.com/45ck/terraform-provider-googleforms/internal/resource_drive_permission"
 	resourceform "github.com/45ck/terraform-provider-googleforms/internal/resource_form"
+	resourceformsync "github.com/45ck/terraform-provider-googleforms/internal/resource_form_sync"
 	resourceformsbatchupdate "github.com/45ck/terraform-provider-googleforms/internal/resource_forms_batch_update"
 	resourceresponsesheet "github.com/45ck/terraform-provider-googleforms/internal/resource_response_sheet"
 	resourcesheet "github.com/45ck/terraform-provider-googleforms/internal/resource_sheet"
@@ -49,6 +51,8 @@ type GoogleFormsProvider struct {
 type GoogleFormsProviderModel struct {
 	Credentials     types.String `tfsdk:"credentials"`
 	ImpersonateUser types.String `tfsdk:"impersonate_user"`
+	ReadResponses   types.Bool   `tfsdk:"read_responses"`
+	ReadDriveFiles  types.Bool   `tfsdk:"read_drive_files"`
 }
 
 // New returns a new provider factory function.
@@ -87,6 +91,19 @@ func (p *GoogleFormsProvider) Schema(
 				Optional:    true,
 				Description: "Email of user to impersonate via domain-wide delegation.",
 			},
+			"read_responses": schema.BoolAttribute{
+				Optional: true,
+				Description: "Also request the forms.responses.readonly OAuth scope, which response_protection and " +
+					"archiving responses with archive_on_destroy need. Defaults to false. With impersonate_user, " +
+					"the scope must be allowed for the client in the Admin console or every request fails.",
+			},
+			"read_drive_files": schema.BoolAttribute{
+				Optional: true,
+				Description: "Also request the drive.readonly OAuth scope, which source_form_id and source_spreadsheet_id " +
+					"need to copy templates the provider did not create, such as files made in the Google UI or shared " +
+					"with its account. Defaults to false. With impersonate_user, the scope must be allowed for the " +
+					"client in the Admin console or every request fails.",
+			},
 		},
 	}
 }
@@ -108,15 +125,21 @@ func (p *GoogleFormsProvider) Configure(
 	if !config.ImpersonateUser.IsNull() && !config.ImpersonateUser.IsUnknown() {
 		impersonateUser = config.ImpersonateUser.ValueString()
 	}
+	opts := client.Options{
+		ReadResponses:  !config.ReadResponses.IsNull() && !config.ReadResponses.IsUnknown() && config.ReadResponses.ValueBool(),
+		ReadDriveFiles: !config.ReadDriveFiles.IsNull() && !config.ReadDriveFiles.IsUnknown() && config.ReadDriveFiles.ValueBool(),
+	}
 
 	tflog.Debug(ctx, "creating Google Forms API client",
 		map[string]interface{}{
 			"has_credentials":  credentialsJSON != "",
 			"impersonate_user": impersonateUser,
+			"read_responses":   opts.ReadResponses,
+			"read_drive_files": opts.ReadDriveFiles,
 		},
 	)
 
-	apiClient, err := client.NewClient(ctx, credentialsJSON, impersonateUser)
+	apiClient, err := client.NewClient(ctx, credentialsJSON, impersonateUser, opts)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Creation Failed",
 			"Unable to create Google Forms API client: "+err.Error(),
@@ -181,6 +204,7 @@ func (p *GoogleFormsProvider) Resources(
 ) []func() resource.Resource {
 	return []func() resource.Resource{
 		resourceform.NewFormResource,
+		resourceformsync.NewFormSyncResource,
 		resourceformsbatchupdate.NewFormsBatchUpdateResource,
 		resourcespreadsheet.NewSpreadsheetResource,
 		resourcesheet.NewSheetResource,
@@ -206,5 +230,6 @@ func (p *GoogleFormsProvider) DataSources(
 		datasourcedrivefile.NewDriveFileDataSource,
 		datasourcespreadsheet.NewSpreadsheetDataSource,
 		datasourcesheetvalues.NewSheetValuesDataSource,
+		datasourceprefilledurl.NewPrefilledURLDataSource,
 	}
 }
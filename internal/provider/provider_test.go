@@ -71,6 +71,12 @@ func TestProviderSchema_HasRequiredAttributes(t *testing.T) {
 	if _, ok := attrs["impersonate_user"]; !ok {
 		t.Error("schema missing 'impersonate_user' attribute")
 	}
+	if _, ok := attrs["read_responses"]; !ok {
+		t.Error("schema missing 'read_responses' attribute")
+	}
+	if _, ok := attrs["read_drive_files"]; !ok {
+		t.Error("schema missing 'read_drive_files' attribute")
+	}
 }
 
 func TestProviderSchema_CredentialsIsSensitive(t *testing.T) {
@@ -100,10 +106,14 @@ func TestProviderConfigure_WithCredentials(t *testing.T) {
 		AttributeTypes: map[string]tftypes.Type{
 			"credentials":      tftypes.String,
 			"impersonate_user": tftypes.String,
+			"read_responses":   tftypes.Bool,
+			"read_drive_files": tftypes.Bool,
 		},
 	}, map[string]tftypes.Value{
 		"credentials":      tftypes.NewValue(tftypes.String, testFakeCredentials()),
 		"impersonate_user": tftypes.NewValue(tftypes.String, nil),
+		"read_responses":   tftypes.NewValue(tftypes.Bool, nil),
+		"read_drive_files": tftypes.NewValue(tftypes.Bool, nil),
 	})
 
 	schemaResp := &provider.SchemaResponse{}
@@ -159,10 +169,14 @@ func TestProviderConfigure_FallbackToEnvVar(t *testing.T) {
 		AttributeTypes: map[string]tftypes.Type{
 			"credentials":      tftypes.String,
 			"impersonate_user": tftypes.String,
+			"read_responses":   tftypes.Bool,
+			"read_drive_files": tftypes.Bool,
 		},
 	}, map[string]tftypes.Value{
 		"credentials":      tftypes.NewValue(tftypes.String, nil),
 		"impersonate_user": tftypes.NewValue(tftypes.String, nil),
+		"read_responses":   tftypes.NewValue(tftypes.Bool, nil),
+		"read_drive_files": tftypes.NewValue(tftypes.Bool, nil),
 	})
 
 	schemaResp := &provider.SchemaResponse{}
@@ -201,10 +215,14 @@ func TestProviderConfigure_FallbackToADC(t *testing.T) {
 		AttributeTypes: map[string]tftypes.Type{
 			"credentials":      tftypes.String,
 			"impersonate_user": tftypes.String,
+			"read_responses":   tftypes.Bool,
+			"read_drive_files": tftypes.Bool,
 		},
 	}, map[string]tftypes.Value{
 		"credentials":      tftypes.NewValue(tftypes.String, nil),
 		"impersonate_user": tftypes.NewValue(tftypes.String, nil),
+		"read_responses":   tftypes.NewValue(tftypes.Bool, nil),
+		"read_drive_files": tftypes.NewValue(tftypes.Bool, nil),
 	})
 
 	schemaResp := &provider.SchemaResponse{}
@@ -241,10 +259,14 @@ func TestProviderConfigure_InvalidCredentials(t *testing.T) {
 		AttributeTypes: map[string]tftypes.Type{
 			"credentials":      tftypes.String,
 			"impersonate_user": tftypes.String,
+			"read_responses":   tftypes.Bool,
+			"read_drive_files": tftypes.Bool,
 		},
 	}, map[string]tftypes.Value{
 		"credentials":      tftypes.NewValue(tftypes.String, "not-valid-json"),
 		"impersonate_user": tftypes.NewValue(tftypes.String, nil),
+		"read_responses":   tftypes.NewValue(tftypes.Bool, nil),
+		"read_drive_files": tftypes.NewValue(tftypes.Bool, nil),
 	})
 
 	schemaResp := &provider.SchemaResponse{}
@@ -300,6 +322,7 @@ func TestProviderResources_RegistersFormResource(t *testing.T) {
 	// Instantiate all resources and ensure the expected ones are registered.
 	want := map[string]bool{
 		"googleforms_form":                           false,
+		"googleforms_form_sync":                      false,
 		"googleforms_response_sheet":                 false,
 		"googleforms_spreadsheet":                    false,
 		"googleforms_sheet":                          false,
@@ -340,8 +363,9 @@ func TestProviderDataSources_RegistersExpectedDataSources(t *testing.T) {
 	}
 
 	want := map[string]bool{
-		"googleforms_spreadsheet":  false,
-		"googleforms_sheet_values": false,
+		"googleforms_spreadsheet":   false,
+		"googleforms_sheet_values":  false,
+		"googleforms_prefilled_url": false,
 	}
 
 	for _, f := range dataSources {
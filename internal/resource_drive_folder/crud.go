@@ -46,16 +46,20 @@ func (r *DriveFolderResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	plan.ID = types.StringValue(created.Id)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	plan.URL = types.StringValue(strings.TrimSpace(created.WebViewLink))
 	if strings.TrimSpace(created.WebViewLink) == "" {
 		plan.URL = types.StringNull()
 	}
 
+	// Partial state save: write ID immediately so Terraform can track the
+	// folder even if a subsequent step fails. Unknown values are saved as null.
+	partial := plan
+	partial.ParentIDs = types.ListNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &partial)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if parents, err := r.client.Drive.GetParents(ctx, created.Id, supportsAllDrives); err == nil {
 		lv, diags := types.ListValueFrom(ctx, types.StringType, parents)
 		resp.Diagnostics.Append(diags...)
@@ -176,13 +180,57 @@ func (r *DriveFolderResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.Drive.Delete(ctx, state.ID.ValueString())
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Drive folder %s has deletion_protection = true. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	policy := "trash"
+	if !state.DeletionPolicy.IsNull() && !state.DeletionPolicy.IsUnknown() && state.DeletionPolicy.ValueString() != "" {
+		policy = state.DeletionPolicy.ValueString()
+	}
+	if policy == "abandon" {
+		tflog.Info(ctx, "abandoning Drive folder; it is removed from state only", map[string]interface{}{"id": state.ID.ValueString()})
+		return
+	}
+
+	supportsAllDrives := false
+	if !state.SupportsAllDrives.IsNull() && !state.SupportsAllDrives.IsUnknown() {
+		supportsAllDrives = state.SupportsAllDrives.ValueBool()
+	}
+
+	var err error
+	if policy == "delete" {
+		err = r.client.Drive.Delete(ctx, state.ID.ValueString())
+	} else {
+		err = r.client.Drive.Trash(ctx, state.ID.ValueString(), supportsAllDrives)
+	}
 	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Delete Drive Folder Failed", err.Error())
 		return
 	}
 }
 
+// ModifyPlan fails plans that destroy a folder while deletion_protection is
+// set. No attribute of the folder forces replacement.
+func (r *DriveFolderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || !req.Plan.Raw.IsNull() {
+		return
+	}
+	var state DriveFolderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.DeletionProtection.ValueBool() {
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("Drive folder %s has deletion_protection = true. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString()),
+	)
+}
+
 func (r *DriveFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
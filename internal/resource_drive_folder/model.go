@@ -10,9 +10,11 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 type DriveFolderResourceModel struct {
 	ID types.String `tfsdk:"id"`
 
-	Name              types.String `tfsdk:"name"`
-	ParentID          types.String `tfsdk:"parent_id"`
-	SupportsAllDrives types.Bool   `tfsdk:"supports_all_drives"`
+	Name               types.String `tfsdk:"name"`
+	ParentID           types.String `tfsdk:"parent_id"`
+	SupportsAllDrives  types.Bool   `tfsdk:"supports_all_drives"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 
 	ParentIDs types.List   `tfsdk:"parent_ids"`
 	URL       types.String `tfsdk:"url"`
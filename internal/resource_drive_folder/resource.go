@@ -15,6 +15,7 @@ import (
 var (
 	_ resource.Resource                = &DriveFolderResource{}
 	_ resource.ResourceWithImportState = &DriveFolderResource{}
+	_ resource.ResourceWithModifyPlan  = &DriveFolderResource{}
 )
 
 // DriveFolderResource implements the googleforms_drive_folder Terraform resource.
@@ -6,11 +6,14 @@ package resourcedrivefolder
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -39,6 +42,21 @@ func (r *DriveFolderResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Default:     booldefault.StaticBool(false),
 				Description: "Whether to support shared drives for this operation.",
 			},
+			"deletion_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("trash"),
+				Description: "What destroying the resource does to the folder. 'trash' (default) moves the folder, and everything in it, to the Drive trash. 'delete' deletes the folder permanently; Drive removes its contents with it. 'abandon' only removes it from Terraform state.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "trash", "abandon"),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "While true (the default), plans that destroy the folder fail. Set it to false and apply before destroying it.",
+			},
 			"parent_ids": schema.ListAttribute{
 				Computed:    true,
 				Description: "Current Drive parent folder IDs (best-effort).",
@@ -0,0 +1,119 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	drive "google.golang.org/api/drive/v3"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// archiveForm writes the form definition and, when include_responses is not
+// false and the responses are readable, its responses as JSON and CSV into
+// the archive_on_destroy folder. A form that cannot be archived is an error,
+// so the form is not deleted; unreadable responses are a warning.
+func (r *FormResource) archiveForm(
+	ctx context.Context,
+	state FormResourceModel,
+	supportsAllDrives bool,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	archive := state.ArchiveOnDestroy
+	if archive == nil || archive.FolderID.ValueString() == "" {
+		return diags
+	}
+	formID := state.ID.ValueString()
+	folderID := archive.FolderID.ValueString()
+
+	form, err := r.client.Forms.Get(ctx, formID)
+	if err != nil {
+		diags.AddError(
+			"Error Archiving Google Form",
+			fmt.Sprintf("Could not read form %s for archive_on_destroy: %s", formID, err),
+		)
+		return diags
+	}
+
+	title := state.Title.ValueString()
+	if form.Info != nil && form.Info.Title != "" {
+		title = form.Info.Title
+	}
+	prefix := fmt.Sprintf("%s (%s) %s", title, formID, time.Now().UTC().Format("20060102T150405Z"))
+
+	upload := func(name, contentType string, content []byte) error {
+		f := &drive.File{Name: prefix + " " + name, Parents: []string{folderID}}
+		created, uerr := r.client.Drive.UploadFile(ctx, f, contentType, content, supportsAllDrives)
+		if uerr != nil {
+			return uerr
+		}
+		tflog.Info(ctx, "archived Google Form file", map[string]interface{}{
+			"form_id": formID,
+			"file_id": created.Id,
+			"name":    f.Name,
+		})
+		return nil
+	}
+
+	formJSON, err := json.MarshalIndent(form, "", "  ")
+	if err == nil {
+		err = upload("form.json", "application/json", formJSON)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Archiving Google Form",
+			fmt.Sprintf("Could not write the definition of form %s to folder %s: %s", formID, folderID, err),
+		)
+		return diags
+	}
+
+	if !archive.IncludeResponses.IsNull() && !archive.IncludeResponses.IsUnknown() && !archive.IncludeResponses.ValueBool() {
+		return diags
+	}
+
+	if !r.client.ReadResponses {
+		diags.AddWarning(
+			"Responses Not Archived",
+			fmt.Sprintf("The provider does not request the forms.responses.readonly scope, so only the definition of form %s was archived. Set read_responses = true in the provider configuration to archive its responses.", formID),
+		)
+		return diags
+	}
+	responses, err := r.client.Forms.ListResponses(ctx, formID)
+	if err != nil {
+		diags.AddWarning(
+			"Responses Not Archived",
+			fmt.Sprintf("Could not read the responses of form %s, so only its definition was archived: %s", formID, err),
+		)
+		return diags
+	}
+	if responses == nil {
+		responses = []*forms.FormResponse{}
+	}
+
+	responsesJSON, err := json.MarshalIndent(responses, "", "  ")
+	if err == nil {
+		err = upload("responses.json", "application/json", responsesJSON)
+	}
+	if err == nil {
+		var responsesCSV []byte
+		responsesCSV, err = convert.ResponsesCSV(form, responses)
+		if err == nil {
+			err = upload("responses.csv", "text/csv", responsesCSV)
+		}
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Archiving Google Form",
+			fmt.Sprintf("Could not write the responses of form %s to folder %s: %s", formID, folderID, err),
+		)
+	}
+	return diags
+}
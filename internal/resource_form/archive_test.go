@@ -0,0 +1,139 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	drive "google.golang.org/api/drive/v3"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+var archiveBlockType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"folder_id":         tftypes.String,
+	"include_responses": tftypes.Bool,
+}}
+
+// runArchiveDelete deletes an answered form with archive_on_destroy set and
+// returns the diagnostics, the names of the uploaded files and whether the
+// form was trashed. readResponses is the read_responses provider setting.
+func runArchiveDelete(t *testing.T, readResponses bool, listErr, uploadErr error) (*resource.DeleteResponse, []string, bool) {
+	t.Helper()
+
+	form, responses := answeredForm("archived-form")
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, _ string) (*forms.Form, error) {
+			return form, nil
+		},
+		ListResponsesFunc: func(_ context.Context, _ string) ([]*forms.FormResponse, error) {
+			return responses, listErr
+		},
+	}
+	var uploads []string
+	trashed := false
+	mockDrive := &testutil.MockDriveAPI{
+		UploadFileFunc: func(_ context.Context, f *drive.File, _ string, content []byte, _ bool) (*drive.File, error) {
+			if len(f.Parents) != 1 || f.Parents[0] != "archive-folder" {
+				t.Errorf("unexpected parents %v", f.Parents)
+			}
+			if len(content) == 0 {
+				t.Errorf("empty archive file %s", f.Name)
+			}
+			uploads = append(uploads, f.Name)
+			return &drive.File{Id: "file-" + f.Name}, uploadErr
+		},
+		TrashFunc: func(_ context.Context, _ string, _ bool) error {
+			trashed = true
+			return nil
+		},
+	}
+	r := testResource(mockForms, mockDrive)
+	r.client.ReadResponses = readResponses
+
+	state := buildState(t, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, "archived-form"),
+		"title": tftypes.NewValue(tftypes.String, "Answered"),
+		"archive_on_destroy": tftypes.NewValue(archiveBlockType, map[string]tftypes.Value{
+			"folder_id":         tftypes.NewValue(tftypes.String, "archive-folder"),
+			"include_responses": tftypes.NewValue(tftypes.Bool, nil),
+		}),
+	})
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+	return resp, uploads, trashed
+}
+
+func TestDelete_ArchiveOnDestroy_WritesFormAndResponses(t *testing.T) {
+	t.Parallel()
+
+	resp, uploads, trashed := runArchiveDelete(t, true, nil, nil)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if !trashed {
+		t.Error("expected the form to be trashed after archiving")
+	}
+	want := []string{"form.json", "responses.json", "responses.csv"}
+	if len(uploads) != len(want) {
+		t.Fatalf("uploads = %v, want %d files", uploads, len(want))
+	}
+	for i, suffix := range want {
+		if !strings.HasPrefix(uploads[i], "Answered (archived-form) ") || !strings.HasSuffix(uploads[i], " "+suffix) {
+			t.Errorf("upload %d = %q, want a %q archive file", i, uploads[i], suffix)
+		}
+	}
+}
+
+func TestDelete_ArchiveOnDestroy_UnreadableResponsesWarn(t *testing.T) {
+	t.Parallel()
+
+	resp, uploads, trashed := runArchiveDelete(t, true, errors.New("forbidden"), nil)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Responses Not Archived" {
+		t.Errorf("expected a Responses Not Archived warning, got %v", resp.Diagnostics.Warnings())
+	}
+	if len(uploads) != 1 || !trashed {
+		t.Errorf("expected only the form definition archived and the form trashed, got uploads %v, trashed %v", uploads, trashed)
+	}
+}
+
+func TestDelete_ArchiveOnDestroy_WithoutReadResponsesWarns(t *testing.T) {
+	t.Parallel()
+
+	resp, uploads, trashed := runArchiveDelete(t, false, nil, nil)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), "read_responses = true") {
+		t.Errorf("expected a warning about read_responses, got %v", resp.Diagnostics.Warnings())
+	}
+	if len(uploads) != 1 || !trashed {
+		t.Errorf("expected only the form definition archived and the form trashed, got uploads %v, trashed %v", uploads, trashed)
+	}
+}
+
+func TestDelete_ArchiveOnDestroy_FailedArchiveKeepsForm(t *testing.T) {
+	t.Parallel()
+
+	resp, _, trashed := runArchiveDelete(t, true, nil, errors.New("quota exceeded"))
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the archive cannot be written")
+	}
+	if trashed {
+		t.Error("the form must not be trashed when archiving fails")
+	}
+}
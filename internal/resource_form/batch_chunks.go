@@ -0,0 +1,339 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// defaultBatchChunkSize is the default maximum number of createItem requests
+// sent in a single batchUpdate call.
+const defaultBatchChunkSize = 100
+
+// privateKeyIncompleteApply marks, in private state, that a chunked item
+// apply stopped part-way. The next update resumes it with targeted requests
+// instead of replacing all items again.
+const privateKeyIncompleteApply = "incomplete_item_apply"
+
+// privateKeyIncompleteCreate marks, in private state, a form whose create
+// failed before its items were sent. Terraform replaces such a tainted form
+// on the next apply, and deletion_protection does not block deleting it.
+const privateKeyIncompleteCreate = "incomplete_create"
+
+// batchChunkSize returns the configured batch_chunk_size.
+func batchChunkSize(plan FormResourceModel) int {
+	if plan.BatchChunkSize.IsNull() || plan.BatchChunkSize.IsUnknown() || plan.BatchChunkSize.ValueInt64() < 1 {
+		return defaultBatchChunkSize
+	}
+	return int(plan.BatchChunkSize.ValueInt64())
+}
+
+// splitBatchRequests splits requests into consecutive chunks holding at most
+// chunkSize createItem requests each. Other requests stay with the createItem
+// requests that follow them; trailing ones join the last chunk.
+func splitBatchRequests(requests []*forms.Request, chunkSize int) [][]*forms.Request {
+	if chunkSize < 1 {
+		chunkSize = defaultBatchChunkSize
+	}
+	var chunks [][]*forms.Request
+	var cur []*forms.Request
+	creates := 0
+	for _, req := range requests {
+		if req != nil && req.CreateItem != nil && creates == chunkSize {
+			chunks = append(chunks, cur)
+			cur, creates = nil, 0
+		}
+		cur = append(cur, req)
+		if req != nil && req.CreateItem != nil {
+			creates++
+		}
+	}
+	if len(cur) > 0 {
+		chunks = append(chunks, cur)
+	}
+	return chunks
+}
+
+// batchUpdateChunked sends requests in chunks (see splitBatchRequests). The
+// returned response holds the replies of all completed chunks, in request
+// order, and the form returned by the last completed chunk; sent is the number
+// of requests those chunks contained. writeControl only applies to the first
+// chunk because every chunk creates a new revision. onChunk, if not nil, is
+// called after each completed chunk except the last.
+func (r *FormResource) batchUpdateChunked(
+	ctx context.Context,
+	formID string,
+	requests []*forms.Request,
+	chunkSize int,
+	writeControl *forms.WriteControl,
+	onChunk func(resp *forms.BatchUpdateFormResponse, sent int),
+) (*forms.BatchUpdateFormResponse, int, error) {
+	merged := &forms.BatchUpdateFormResponse{}
+	chunks := splitBatchRequests(requests, chunkSize)
+	sent := 0
+	for i, chunk := range chunks {
+		tflog.Debug(ctx, "executing batchUpdate chunk", map[string]interface{}{
+			"chunk":         i + 1,
+			"chunk_count":   len(chunks),
+			"request_count": len(chunk),
+		})
+
+		batchReq := &forms.BatchUpdateFormRequest{
+			Requests:              chunk,
+			IncludeFormInResponse: true,
+		}
+		if i == 0 {
+			batchReq.WriteControl = writeControl
+		}
+
+		apiResp, err := r.client.Forms.BatchUpdate(ctx, formID, batchReq)
+		if err != nil {
+			if len(chunks) > 1 {
+				err = fmt.Errorf("chunk %d of %d: %w", i+1, len(chunks), err)
+			}
+			return merged, sent, err
+		}
+		if apiResp != nil {
+			merged.Replies = append(merged.Replies, apiResp.Replies...)
+			merged.Form = apiResp.Form
+			merged.WriteControl = apiResp.WriteControl
+		}
+		sent += len(chunk)
+
+		if onChunk != nil && i < len(chunks)-1 {
+			onChunk(merged, sent)
+		}
+	}
+	return merged, sent, nil
+}
+
+// itemProgressState returns base with the items created so far recorded, so
+// that an interrupted apply can be resumed. createdIDs are the IDs of the
+// created items in creation order, which is plan order. In content_json mode
+// they are recorded as content_json_item_ids; otherwise the matching prefix of
+// the planned items is recorded with google_item_id set.
+func itemProgressState(
+	ctx context.Context,
+	base FormResourceModel,
+	plan FormResourceModel,
+	createdIDs []string,
+) (FormResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	base.ContentJSON = plan.ContentJSON
+	// Every chunk creates a new revision and changes the content.
+	base.RevisionID = types.StringNull()
+	base.ContentHash = types.StringNull()
+
+	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
+		ids, d := contentJSONItemIDsToTF(ctx, append([]string{}, createdIDs...))
+		diags.Append(d...)
+		base.ContentJSONItemIDs = ids
+		base.Items = plan.Items
+		return base, diags
+	}
+
+	var planItems []ItemModel
+	if !plan.Items.IsNull() && !plan.Items.IsUnknown() {
+		diags.Append(plan.Items.ElementsAs(ctx, &planItems, false)...)
+		if diags.HasError() {
+			return base, diags
+		}
+	}
+	if len(createdIDs) > len(planItems) {
+		createdIDs = createdIDs[:len(planItems)]
+	}
+
+	done := make([]ItemModel, len(createdIDs))
+	for i, id := range createdIDs {
+		done[i] = planItems[i]
+		done[i].GoogleItemID = types.StringValue(id)
+	}
+	if len(done) == 0 {
+		base.Items = types.ListNull(itemObjectType())
+		return base, diags
+	}
+	items, d := types.ListValueFrom(ctx, itemObjectType(), done)
+	diags.Append(d...)
+	base.Items = items
+	return base, diags
+}
+
+// targetedProgressState returns state with the items a targeted update has
+// created so far recorded. keyToID maps the item keys of desired to the IDs of
+// the items they are applied to. Items that were deleted or changed keep their
+// old state, so the next apply plans them again.
+func targetedProgressState(
+	ctx context.Context,
+	state FormResourceModel,
+	plan FormResourceModel,
+	desired []convert.ItemModel,
+	keyToID map[string]string,
+) (FormResourceModel, diag.Diagnostics) {
+	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
+		ids := make([]string, len(desired))
+		for i, it := range desired {
+			ids[i] = keyToID[it.ItemKey]
+		}
+		return itemProgressState(ctx, state, plan, ids)
+	}
+
+	var diags diag.Diagnostics
+	state.RevisionID = types.StringNull()
+	state.ContentHash = types.StringNull()
+
+	var stateItems, planItems []ItemModel
+	if !state.Items.IsNull() && !state.Items.IsUnknown() {
+		diags.Append(state.Items.ElementsAs(ctx, &stateItems, false)...)
+	}
+	if !plan.Items.IsNull() && !plan.Items.IsUnknown() {
+		diags.Append(plan.Items.ElementsAs(ctx, &planItems, false)...)
+	}
+	if diags.HasError() {
+		return state, diags
+	}
+
+	inState := make(map[string]bool, len(stateItems))
+	for i := range stateItems {
+		key := stateItems[i].ItemKey.ValueString()
+		inState[key] = true
+		if gid, ok := keyToID[key]; ok {
+			stateItems[i].GoogleItemID = types.StringValue(gid)
+		}
+	}
+	for _, it := range planItems {
+		key := it.ItemKey.ValueString()
+		if gid, ok := keyToID[key]; ok && !inState[key] {
+			it.GoogleItemID = types.StringValue(gid)
+			stateItems = append(stateItems, it)
+		}
+	}
+	if len(stateItems) == 0 {
+		state.Items = types.ListNull(itemObjectType())
+		return state, diags
+	}
+	items, d := types.ListValueFrom(ctx, itemObjectType(), stateItems)
+	diags.Append(d...)
+	state.Items = items
+	return state, diags
+}
+
+// saveResumableCreate saves a create whose chunked batchUpdate stopped
+// part-way; done holds the replies to the sent requests. The saved state is
+// the plan, with the items that were not created yet left without
+// google_item_id, so that it matches the plan and Terraform does not taint
+// the form. incomplete_item_apply makes the next apply finish the create.
+func saveResumableCreate(
+	ctx context.Context,
+	resp *resource.CreateResponse,
+	plan FormResourceModel,
+	done *forms.BatchUpdateFormResponse,
+	sent []*forms.Request,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ids, err := createdItemIDs(done, sent)
+	if err != nil {
+		diags.AddError("Item ID Correlation Failed", err.Error())
+		return diags
+	}
+	state, d := resumableCreateState(ctx, plan, ids)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(setFormState(ctx, &resp.State, state)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(nullUnknownValues(&resp.State)...)
+	if resp.Private != nil {
+		diags.Append(resp.Private.SetKey(ctx, privateKeyIncompleteApply, []byte("true"))...)
+		diags.Append(resp.Private.SetKey(ctx, privateKeyIncompleteCreate, nil)...)
+	}
+	return diags
+}
+
+// resumableCreateState returns the planned state of a create that stopped
+// after creating the items createdIDs, in plan order.
+func resumableCreateState(ctx context.Context, plan FormResourceModel, createdIDs []string) (FormResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := partialCreateState(plan)
+	state.Published = plan.Published
+	state.AcceptingResponses = plan.AcceptingResponses
+	state.FolderID = plan.FolderID
+
+	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
+		ids, d := contentJSONItemIDsToTF(ctx, append([]string{}, createdIDs...))
+		diags.Append(d...)
+		state.ContentJSONItemIDs = ids
+		state.Items = plan.Items
+		return state, diags
+	}
+
+	var planItems []ItemModel
+	if !plan.Items.IsNull() && !plan.Items.IsUnknown() {
+		diags.Append(plan.Items.ElementsAs(ctx, &planItems, false)...)
+	}
+	if diags.HasError() || len(planItems) == 0 {
+		state.Items = plan.Items
+		return state, diags
+	}
+	for i := range planItems {
+		planItems[i].GoogleItemID = types.StringNull()
+		if i < len(createdIDs) {
+			planItems[i].GoogleItemID = types.StringValue(createdIDs[i])
+		}
+	}
+	items, d := types.ListValueFrom(ctx, itemObjectType(), planItems)
+	diags.Append(d...)
+	state.Items = items
+	return state, diags
+}
+
+// nullUnknownValues replaces the values of st that are not known yet, such
+// as computed attributes of items that were not created, with null.
+// Terraform rejects unknown values in the state an apply returns.
+func nullUnknownValues(st *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	raw, err := tftypes.Transform(st.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		diags.AddError("Error Saving Incomplete Create", err.Error())
+		return diags
+	}
+	st.Raw = raw
+	return diags
+}
+
+// planResume plans an update of a form whose last apply stopped part-way,
+// even when its saved state matches the configuration, by leaving
+// revision_id unknown. planned_item_operations is left unknown so that the
+// remaining item operations are previewed.
+func planResume(ctx context.Context, private privateStateGetter, state tfsdk.State, plan *tfsdk.Plan) diag.Diagnostics {
+	if state.Raw.IsNull() || plan.Raw.IsNull() || private == nil {
+		return nil
+	}
+	incomplete, diags := private.GetKey(ctx, privateKeyIncompleteApply)
+	if diags.HasError() || len(incomplete) == 0 {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("revision_id"), types.StringUnknown())...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("planned_item_operations"), types.ListUnknown(plannedItemOperationObjectType()))...)
+	return diags
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"testing"
+
+	forms "google.golang.org/api/forms/v1"
+)
+
+func TestSplitBatchRequests_ChunksByCreateItemCount(t *testing.T) {
+	t.Parallel()
+
+	info := &forms.Request{UpdateFormInfo: &forms.UpdateFormInfoRequest{}}
+	del := &forms.Request{DeleteItem: &forms.DeleteItemRequest{}}
+	quiz := &forms.Request{UpdateSettings: &forms.UpdateSettingsRequest{}}
+	create := func() *forms.Request { return &forms.Request{CreateItem: &forms.CreateItemRequest{}} }
+
+	requests := []*forms.Request{info, del, create(), create(), create(), create(), create(), quiz}
+	chunks := splitBatchRequests(requests, 2)
+
+	if len(chunks) != 3 {
+		t.Fatalf("chunks = %d, want 3", len(chunks))
+	}
+	wantLens := []int{4, 2, 2}
+	total := 0
+	for i, c := range chunks {
+		if len(c) != wantLens[i] {
+			t.Errorf("chunk %d has %d requests, want %d", i, len(c), wantLens[i])
+		}
+		total += len(c)
+	}
+	if total != len(requests) {
+		t.Errorf("requests across chunks = %d, want %d", total, len(requests))
+	}
+	if chunks[0][0] != info || chunks[0][1] != del {
+		t.Error("non-create requests must stay at the start of the first chunk")
+	}
+	if chunks[2][1] != quiz {
+		t.Error("trailing non-create requests must join the last chunk")
+	}
+}
+
+func TestSplitBatchRequests_NoCreates_SingleChunk(t *testing.T) {
+	t.Parallel()
+
+	requests := []*forms.Request{
+		{UpdateFormInfo: &forms.UpdateFormInfoRequest{}},
+		{UpdateSettings: &forms.UpdateSettingsRequest{}},
+	}
+	if chunks := splitBatchRequests(requests, 1); len(chunks) != 1 || len(chunks[0]) != 2 {
+		t.Fatalf("chunks = %v, want a single chunk with both requests", chunks)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// privateKeyFormContent holds, in private state, the content of the form as of
+// the last apply (convert.FormContentJSON). conflict_policy =
+// "fail_on_content_drift" diffs it against the live form to show what was
+// changed outside Terraform.
+const privateKeyFormContent = "form_content"
+
+// formContent returns the content_hash of a form as applied and the content to
+// keep in private state. Failing to compute them only disables drift
+// detection for the next apply, so it is a warning.
+func formContent(f *forms.Form) (types.String, []byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	content, err := convert.FormContentJSON(f)
+	if err == nil {
+		var hash string
+		if hash, err = convert.HashJSON(content); err == nil {
+			return types.StringValue(hash), []byte(content), diags
+		}
+	}
+	diags.AddWarning(
+		"Content Hash Unavailable",
+		fmt.Sprintf("Could not hash the content of form %s: %s. conflict_policy = \"fail_on_content_drift\" cannot detect changes made before the next apply.", f.FormId, err),
+	)
+	return types.StringNull(), nil, diags
+}
+
+// checkContentDrift fails when the content of the live form no longer matches
+// the content_hash recorded at the last apply. lastApplied is the content kept
+// in private state; when present, the error lists the changes.
+func checkContentDrift(ctx context.Context, state FormResourceModel, lastApplied []byte, current *forms.Form) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.ContentHash.IsNull() || state.ContentHash.IsUnknown() || state.ContentHash.ValueString() == "" {
+		diags.AddWarning(
+			"Content Drift Detection Not Available",
+			"conflict_policy is \"fail_on_content_drift\" but content_hash is not available in state, for example after an import. Proceeding; this apply records the hash.",
+		)
+		return diags
+	}
+
+	hash, _, d := formContent(current)
+	diags.Append(d...)
+	if hash.IsNull() || hash.ValueString() == state.ContentHash.ValueString() {
+		return diags
+	}
+
+	detail := fmt.Sprintf("Form %s was edited outside Terraform since the last apply (content_hash state=%s current=%s).",
+		current.FormId, state.ContentHash.ValueString(), hash.ValueString())
+	detail += "\n\n" + contentDriftChanges(ctx, state, lastApplied, current)
+	detail += "\n\nReview the changes, then set conflict_policy = \"overwrite\" for one apply to replace them with the configuration."
+	diags.AddError("Form Changed Outside Terraform", detail)
+	return diags
+}
+
+// contentDriftChanges describes the changes between the form as last applied
+// and the live form as a convert.FormDiff in JSON.
+func contentDriftChanges(ctx context.Context, state FormResourceModel, lastApplied []byte, current *forms.Form) string {
+	if len(lastApplied) == 0 {
+		return "The form as last applied is not stored, so the changes cannot be listed."
+	}
+	var previous forms.Form
+	if err := json.Unmarshal(lastApplied, &previous); err != nil {
+		return "The form as last applied could not be read, so the changes cannot be listed: " + err.Error()
+	}
+
+	keyMap, _ := buildItemKeyMap(ctx, state.Items)
+	from, err := convert.FormToModel(&previous, keyMap)
+	if err != nil {
+		return "The changes cannot be listed: " + err.Error()
+	}
+	to, err := convert.FormToModel(current, keyMap)
+	if err != nil {
+		return "The changes cannot be listed: " + err.Error()
+	}
+
+	diff := convert.DiffForms(from, to)
+	if diff.Empty() {
+		return "The changes are in form settings or item details the diff does not compare; compare the form in the Google Forms editor."
+	}
+	out, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return "The changes cannot be listed: " + err.Error()
+	}
+	return "Changes:\n" + string(out)
+}
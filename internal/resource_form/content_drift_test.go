@@ -0,0 +1,135 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func TestCheckContentDrift(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	applied := formWithItems("drift-form", "Survey")
+	applied.RevisionId = "rev-1"
+	hash, content, diags := formContent(applied)
+	if diags.HasError() || hash.IsNull() {
+		t.Fatalf("formContent() failed: %v", diags)
+	}
+	state := FormResourceModel{ContentHash: hash, Items: types.ListNull(itemObjectType())}
+
+	// A new revision without content changes is not drift.
+	current := formWithItems("drift-form", "Survey")
+	current.RevisionId = "rev-2"
+	if diags := checkContentDrift(ctx, state, content, current); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+
+	// Nor is a new signed contentUri for an unchanged image.
+	withImage := func(contentURI string) *forms.Form {
+		f := formWithItems("drift-form", "Survey")
+		f.Items = append(f.Items, &forms.Item{
+			ItemId:    "img_1",
+			ImageItem: &forms.ImageItem{Image: &forms.Image{ContentUri: contentURI}},
+		})
+		return f
+	}
+	imageHash, imageContent, diags := formContent(withImage("https://lh3.example/img?sig=1"))
+	if diags.HasError() {
+		t.Fatalf("formContent() failed: %v", diags)
+	}
+	imageState := FormResourceModel{ContentHash: imageHash, Items: types.ListNull(itemObjectType())}
+	if diags := checkContentDrift(ctx, imageState, imageContent, withImage("https://lh3.example/img?sig=2")); diags.HasError() {
+		t.Fatalf("a new contentUri was reported as drift: %v", diags.Errors())
+	}
+
+	current.Items[0].Title = "Full name?"
+	diags = checkContentDrift(ctx, state, content, current)
+	expectErrorContains(t, diags, "Form Changed Outside Terraform")
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{`"change": "changed"`, `"title": "Full name?"`, `"from": "\"Name?\""`} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail does not contain %s:\n%s", want, detail)
+		}
+	}
+}
+
+func TestUpdate_FailOnContentDrift(t *testing.T) {
+	t.Parallel()
+
+	applied, _, _ := formContent(basicFormResponse("drift-form", "Old Title"))
+	for _, tc := range []struct {
+		name      string
+		stateHash string
+		wantError bool
+	}{
+		{name: "unchanged form", stateHash: applied.ValueString()},
+		{name: "changed form", stateHash: "stale", wantError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			batchCalls := 0
+			getCalls := 0
+			mockForms := &testutil.MockFormsAPI{
+				GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+					getCalls++
+					if getCalls == 1 {
+						return basicFormResponse(formID, "Old Title"), nil
+					}
+					return basicFormResponse(formID, "New Title"), nil
+				},
+				BatchUpdateFunc: func(_ context.Context, _ string, _ *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+					batchCalls++
+					return &forms.BatchUpdateFormResponse{}, nil
+				},
+			}
+			r := testResource(mockForms, &testutil.MockDriveAPI{})
+			ctx := context.Background()
+
+			vals := func(title string) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"id":                  tftypes.NewValue(tftypes.String, "drift-form"),
+					"title":               tftypes.NewValue(tftypes.String, title),
+					"published":           tftypes.NewValue(tftypes.Bool, false),
+					"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+					"quiz":                tftypes.NewValue(tftypes.Bool, false),
+					"conflict_policy":     tftypes.NewValue(tftypes.String, "fail_on_content_drift"),
+				}
+			}
+			stateVals := vals("Old Title")
+			stateVals["content_hash"] = tftypes.NewValue(tftypes.String, tc.stateHash)
+			state := buildState(t, stateVals)
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: buildPlan(t, vals("New Title")), State: state}, resp)
+
+			if tc.wantError {
+				expectErrorContains(t, resp.Diagnostics, "Form Changed Outside Terraform")
+				if batchCalls != 0 {
+					t.Errorf("expected no batchUpdate calls, got %d", batchCalls)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			var got FormResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			want, _, _ := formContent(basicFormResponse("drift-form", "New Title"))
+			if got.ContentHash.ValueString() != want.ValueString() {
+				t.Errorf("content_hash = %s, want the hash of the updated form %s", got.ContentHash, want)
+			}
+		})
+	}
+}
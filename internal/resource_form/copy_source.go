@@ -0,0 +1,86 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+
+	drive "google.golang.org/api/drive/v3"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// copySourceForm creates the form as a Drive copy of source_form_id and
+// returns the new form ID.
+func (r *FormResource) copySourceForm(ctx context.Context, plan FormResourceModel, supportsAllDrives bool) (string, error) {
+	f, err := r.client.Drive.CopyFile(ctx, plan.SourceFormID.ValueString(), &drive.File{
+		Name: plan.Title.ValueString(),
+	}, supportsAllDrives)
+	if err != nil {
+		return "", r.client.CopySourceError(err)
+	}
+	if f == nil || f.Id == "" {
+		return "", fmt.Errorf("copy of form %s returned no file ID", plan.SourceFormID.ValueString())
+	}
+	return f.Id, nil
+}
+
+// adoptCopiedItems returns the requests that turn the items of a copied
+// template form into the desired items, keeping the copied items that
+// correlate with a desired item (see convert.AdoptItems). createKeys holds the
+// item_keys of the createItem requests, in request order, and keyMap maps the
+// IDs of the adopted items to their item_keys.
+func adoptCopiedItems(
+	copied *forms.Form,
+	desired []convert.ItemModel,
+	plan FormResourceModel,
+) (requests []*forms.Request, createKeys []string, keyMap map[string]string, err error) {
+	keyToID, err := convert.AdoptItems(copied.Items, desired)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	partial := plan.ManageMode.ValueString() == "partial"
+	managed := make(map[string]bool, len(copied.Items))
+	if partial {
+		// Copied items that no item block adopts are left unmanaged.
+		for _, id := range keyToID {
+			managed[id] = true
+		}
+	} else {
+		for _, it := range copied.Items {
+			if it != nil && it.ItemId != "" {
+				managed[it.ItemId] = true
+			}
+		}
+	}
+
+	itemPlan, err := convert.PlanTargetedUpdate(convert.TargetedInput{
+		Current:              copied.Items,
+		Desired:              desired,
+		KeyToID:              keyToID,
+		Managed:              managed,
+		Partial:              partial,
+		AppendNew:            plan.PartialNewItemPolicy.ValueString() != "plan_index",
+		AllowTypeReplacement: plan.AllowTypeReplacement.ValueBool(),
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	requests = append(requests, itemPlan.Deletes...)
+	requests = append(requests, itemPlan.Moves...)
+	requests = append(requests, itemPlan.Updates...)
+	requests = append(requests, itemPlan.Replacements...)
+	requests = append(requests, itemPlan.Creates...)
+	createKeys = append(append(createKeys, itemPlan.ReplaceKeys...), itemPlan.CreateKeys...)
+
+	keyMap = make(map[string]string, len(itemPlan.KeyToID))
+	for key, id := range itemPlan.KeyToID {
+		keyMap[id] = key
+	}
+	return requests, createKeys, keyMap, nil
+}
@@ -22,58 +22,95 @@ func (r *FormResource) Create(
 	resp *resource.CreateResponse,
 ) {
 	var plan FormResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(getFormModel(ctx, req.Plan, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	supportsAllDrives := false
+	if !plan.SupportsAllDrives.IsNull() && !plan.SupportsAllDrives.IsUnknown() {
+		supportsAllDrives = plan.SupportsAllDrives.ValueBool()
+	}
+	copying := !plan.SourceFormID.IsNull() && !plan.SourceFormID.IsUnknown() && plan.SourceFormID.ValueString() != ""
+	// Validation cannot tell whether values it does not know yet are set.
+	if copying && !plan.ContentJSON.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting Configuration",
+			`Cannot use both "content_json" and "source_form_id" in the same form resource. Use item and section blocks to adopt the items of the copied form.`,
+		)
+		return
+	}
+
 	// Step 1: Create the form with title only (API limitation).
 	// The Google Forms API only accepts Info.Title during creation;
-	// all other fields must be set via batchUpdate.
+	// all other fields must be set via batchUpdate. With source_form_id the
+	// form is a Drive copy of the template instead.
 	tflog.Debug(ctx, "creating Google Form", map[string]interface{}{
-		"title": plan.Title.ValueString(),
+		"title":          plan.Title.ValueString(),
+		"source_form_id": plan.SourceFormID.ValueString(),
 	})
 
-	createForm := &forms.Form{
-		Info: &forms.Info{
-			Title: plan.Title.ValueString(),
-		},
-	}
+	var formID string
+	if copying {
+		id, err := r.copySourceForm(ctx, plan, supportsAllDrives)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Copying Google Form",
+				fmt.Sprintf("Could not copy source form %s: %s", plan.SourceFormID.ValueString(), err),
+			)
+			return
+		}
+		formID = id
+	} else {
+		createForm := &forms.Form{
+			Info: &forms.Info{
+				Title: plan.Title.ValueString(),
+			},
+		}
 
-	result, err := r.client.Forms.Create(ctx, createForm)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Google Form",
-			fmt.Sprintf("Could not create form: %s", err),
-		)
-		return
+		result, err := r.client.Forms.Create(ctx, createForm)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Google Form",
+				fmt.Sprintf("Could not create form: %s", err),
+			)
+			return
+		}
+		formID = result.FormId
 	}
 
 	tflog.Info(ctx, "created Google Form", map[string]interface{}{
-		"form_id": result.FormId,
+		"form_id": formID,
 	})
 
 	// Step 2: CRITICAL partial state save. Persist the form ID immediately
 	// so the resource can be tracked even if subsequent API calls fail.
 	// This prevents orphaned forms that exist in Google but not in state.
-	plan.ID = types.StringValue(result.FormId)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	// The partial state is updated as each later step succeeds, so a failed
+	// create leaves a tainted resource describing what was actually applied.
+	plan.ID = types.StringValue(formID)
+	partial := partialCreateState(plan)
+	resp.Diagnostics.Append(setFormState(ctx, &resp.State, partial)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	formID := result.FormId
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyIncompleteCreate, []byte("true"))...)
+	}
 
 	// Step 3: Build batch update requests for all settings and items.
 	var requests []*forms.Request
 	var createKeys []string
 	var desiredItems []convert.ItemModel
+	// adoptedKeyMap maps the items of a copied form that item blocks adopt
+	// to their item_keys.
+	var adoptedKeyMap map[string]string
 
 	// Always send title+description via batchUpdate to ensure description is set.
 	description := plan.Description.ValueString()
 	requests = append(requests, convert.BuildUpdateInfoRequest(plan.Title.ValueString(), description))
 
-	// Enable quiz mode if requested.
+	// Enable quiz mode if requested, before items with grading are created.
 	if plan.Quiz.ValueBool() {
 		requests = append(requests, convert.BuildQuizSettingsRequest(true))
 	}
@@ -103,7 +140,38 @@ func (r *FormResource) Create(
 		}
 		desiredItems = convertItems
 
-		if len(convertItems) > 0 {
+		switch {
+		case copying:
+			copied, err := r.client.Forms.Get(ctx, formID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Copied Google Form",
+					fmt.Sprintf("Form was copied (ID: %s) but could not be read: %s", formID, err),
+				)
+				return
+			}
+			itemRequests, keys, adopted, err := adoptCopiedItems(copied, convertItems, plan)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Adopting Copied Items",
+					fmt.Sprintf("Could not correlate the items of copied form %s with the item blocks: %s", formID, err),
+				)
+				return
+			}
+			tflog.Debug(ctx, "adopting items of copied form", map[string]interface{}{
+				"adopted_count": len(adopted),
+				"create_count":  len(keys),
+			})
+			requests = append(requests, itemRequests...)
+			// A copied form may be a quiz. Like in updateReplaceAll, quiz
+			// mode is only turned off after its graded items are deleted
+			// or replaced.
+			if !plan.Quiz.ValueBool() {
+				requests = append(requests, convert.BuildQuizSettingsRequest(false))
+			}
+			createKeys = keys
+			adoptedKeyMap = adopted
+		case len(convertItems) > 0:
 			var planItems []ItemModel
 			diags := plan.Items.ElementsAs(ctx, &planItems, false)
 			resp.Diagnostics.Append(diags...)
@@ -130,19 +198,43 @@ func (r *FormResource) Create(
 		}
 	}
 
-	// Step 4: Execute batchUpdate if there are any requests.
+	// Step 4: Execute batchUpdate if there are any requests. Item creates are
+	// sent in chunks; after each chunk the created items are saved to state so
+	// that an interrupted create can be resumed.
 	var batchResp *forms.BatchUpdateFormResponse
 	if len(requests) > 0 {
 		tflog.Debug(ctx, "executing batchUpdate", map[string]interface{}{
 			"request_count": len(requests),
 		})
 
-		batchReq := &forms.BatchUpdateFormRequest{
-			Requests:              requests,
-			IncludeFormInResponse: true,
+		// Progress is recorded by plan position, which does not apply to
+		// the adopted items of a copied form.
+		var saveProgress func(done *forms.BatchUpdateFormResponse, sent int)
+		if !copying {
+			saveProgress = func(done *forms.BatchUpdateFormResponse, sent int) {
+				ids, err := createdItemIDs(done, requests[:sent])
+				if err != nil {
+					return
+				}
+				progress, diags := itemProgressState(ctx, partial, plan, ids)
+				if diags.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(setFormState(ctx, &resp.State, progress)...)
+			}
 		}
 
-		apiResp, err := r.client.Forms.BatchUpdate(ctx, formID, batchReq)
+		apiResp, sent, err := r.batchUpdateChunked(ctx, formID, requests, batchChunkSize(plan), nil, saveProgress)
+		if err != nil && sent > 0 && !copying {
+			// Finish the create in the next apply rather than tainting the
+			// form and starting over.
+			resp.Diagnostics.Append(saveResumableCreate(ctx, resp, plan, apiResp, requests[:sent])...)
+			resp.Diagnostics.AddWarning(
+				"Form Create Incomplete",
+				fmt.Sprintf("Form was created (ID: %s) but batchUpdate failed after %d of %d requests: %s. The items created so far were saved to state; apply again to create the remaining items and apply the remaining settings.", formID, sent, len(requests), err),
+			)
+			return
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating Google Form",
@@ -151,6 +243,26 @@ func (r *FormResource) Create(
 			return
 		}
 		batchResp = apiResp
+
+		if ids, err := createdItemIDs(batchResp, requests); err == nil && !copying {
+			progress, diags := itemProgressState(ctx, partial, plan, ids)
+			if !diags.HasError() {
+				partial = progress
+				resp.Diagnostics.Append(setFormState(ctx, &resp.State, partial)...)
+			}
+		}
+	}
+
+	// In content_json mode, record the created item IDs by index so later
+	// targeted updates can correlate the JSON items with the form.
+	var contentJSONItemIDs []string
+	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
+		ids, err := createdItemIDs(batchResp, requests)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Item ID Correlation Failed", err.Error())
+		} else {
+			contentJSONItemIDs = ids
+		}
 	}
 
 	// Step 5: Set publish settings if published or accepting_responses is true.
@@ -170,18 +282,19 @@ func (r *FormResource) Create(
 			)
 			return
 		}
+		partial.Published = plan.Published
+		partial.AcceptingResponses = plan.AcceptingResponses
+		resp.Diagnostics.Append(setFormState(ctx, &resp.State, partial)...)
 	}
 
 	// Step 5b: Optional: move the form into a Drive folder.
-	supportsAllDrives := false
-	if !plan.SupportsAllDrives.IsNull() && !plan.SupportsAllDrives.IsUnknown() {
-		supportsAllDrives = plan.SupportsAllDrives.ValueBool()
-	}
 	if !plan.FolderID.IsNull() && !plan.FolderID.IsUnknown() && plan.FolderID.ValueString() != "" {
 		if err := r.client.Drive.MoveToFolder(ctx, formID, plan.FolderID.ValueString(), supportsAllDrives); err != nil {
 			resp.Diagnostics.AddError("Move Form To Folder Failed", err.Error())
 			return
 		}
+		partial.FolderID = plan.FolderID
+		resp.Diagnostics.Append(setFormState(ctx, &resp.State, partial)...)
 	}
 	// Best-effort: record current parents.
 	if parents, err := r.client.Drive.GetParents(ctx, formID, supportsAllDrives); err == nil {
@@ -225,6 +338,16 @@ func (r *FormResource) Create(
 		}
 	}
 
+	// Items adopted from a copied form keep their IDs.
+	if copying {
+		if keyMap == nil {
+			keyMap = make(map[string]string, len(adoptedKeyMap))
+		}
+		for id, key := range adoptedKeyMap {
+			keyMap[id] = key
+		}
+	}
+
 	// Fallback: correlate by position if reply correlation was unavailable.
 	if keyMap == nil && !plan.Items.IsNull() && !plan.Items.IsUnknown() {
 		var planItems []ItemModel
@@ -261,7 +384,13 @@ func (r *FormResource) Create(
 		}
 	}
 
-	formModel, err := convert.FormToModel(finalForm, keyMap)
+	readOpts, optDiags := buildFormToModelOptions(ctx, plan.Items)
+	resp.Diagnostics.Append(optDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formModel, err := convert.FormToModelWithOptions(finalForm, keyMap, readOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Form Response",
@@ -270,6 +399,11 @@ func (r *FormResource) Create(
 		return
 	}
 
+	// Copied items that no item block adopted stay unmanaged in partial mode.
+	if copying && plan.ManageMode.ValueString() == "partial" {
+		formModel.Items = filterItemsByKeyMap(formModel.Items, keyMap)
+	}
+
 	// Preserve input-only fields that may not be returned by the API.
 	formModel.Items, diags = overlayConvertItemInputsFromTF(ctx, formModel.Items, plan.Items)
 	resp.Diagnostics.Append(diags...)
@@ -278,6 +412,13 @@ func (r *FormResource) Create(
 	}
 
 	state := convertFormModelToTFState(formModel, plan)
+	unmanagedItems, d := appliedUnmanagedItems(finalForm, formModel.Items, contentJSONItemIDs)
+	resp.Diagnostics.Append(d...)
+	state.UnmanagedItems = unmanagedItems
+	// Record the content as applied for conflict_policy = "fail_on_content_drift".
+	hash, content, d := formContent(finalForm)
+	resp.Diagnostics.Append(d...)
+	state.ContentHash = hash
 
 	// Set items in state (unless using content_json mode).
 	if plan.ContentJSON.IsNull() || plan.ContentJSON.IsUnknown() || plan.ContentJSON.ValueString() == "" {
@@ -290,8 +431,44 @@ func (r *FormResource) Create(
 	} else {
 		// In content_json mode, keep the items list null.
 		state.Items = plan.Items
+		ids, diags := contentJSONItemIDsToTF(ctx, contentJSONItemIDs)
+		resp.Diagnostics.Append(diags...)
+		state.ContentJSONItemIDs = ids
 	}
 
+	// Step 7c: Best-effort: record the item_key mapping on the Drive file so
+	// that an import can recover it.
+	resp.Diagnostics.Append(r.saveItemKeyProperties(ctx, formID, state.Items, supportsAllDrives)...)
+
 	// Step 8: Save final state.
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setFormState(ctx, &resp.State, state)...)
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyIncompleteCreate, nil)...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyFormContent, content)...)
+	}
+}
+
+// partialCreateState returns the state to save right after the form itself
+// was created: every value that is not known yet is null, and settings that
+// are applied by later steps (publishing, the folder move) are recorded as not
+// applied. Saving unknown values would make Terraform reject the state.
+func partialCreateState(plan FormResourceModel) FormResourceModel {
+	partial := plan
+	partial.Published = types.BoolValue(false)
+	partial.AcceptingResponses = types.BoolValue(false)
+	partial.FolderID = types.StringNull()
+	if partial.EmailCollectionType.IsUnknown() {
+		partial.EmailCollectionType = types.StringNull()
+	}
+	partial.ParentIDs = types.ListNull(types.StringType)
+	partial.Items = types.ListNull(itemObjectType())
+	partial.ContentJSONItemIDs = types.ListNull(types.StringType)
+	partial.PlannedItemOperations = plannedItemOperationsForState(plan.PlannedItemOperations)
+	partial.ResponderURI = types.StringNull()
+	partial.EditURI = types.StringValue("https://docs.google.com/forms/d/" + plan.ID.ValueString() + "/edit")
+	partial.DocumentTitle = types.StringNull()
+	partial.RevisionID = types.StringNull()
+	partial.ContentHash = types.StringNull()
+	partial.UnmanagedItems = types.ListNull(unmanagedItemObjectType())
+	return partial
 }
@@ -13,7 +13,9 @@ import (
 	"github.com/45ck/terraform-provider-googleforms/internal/client"
 )
 
-// Delete removes a Google Form by trashing it via the Drive API.
+// Delete removes a Google Form according to deletion_policy: it is moved to
+// the Drive trash (default), permanently deleted, or left in place. Forms
+// that are trashed or deleted are first archived per archive_on_destroy.
 func (r *FormResource) Delete(
 	ctx context.Context,
 	req resource.DeleteRequest,
@@ -25,15 +27,52 @@ func (r *FormResource) Delete(
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() && !createIncomplete(ctx, req.Private) {
+		resp.Diagnostics.AddError("Deletion Protection Enabled", deletionProtectedDetail(state))
+		return
+	}
+
 	formID := state.ID.ValueString()
+	policy := "trash"
+	if !state.DeletionPolicy.IsNull() && !state.DeletionPolicy.IsUnknown() && state.DeletionPolicy.ValueString() != "" {
+		policy = state.DeletionPolicy.ValueString()
+	}
+	if policy == "abandon" {
+		tflog.Info(ctx, "abandoning Google Form; it is removed from state only", map[string]interface{}{
+			"form_id": formID,
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(r.guardFormResponseLoss(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	supportsAllDrives := false
+	if !state.SupportsAllDrives.IsNull() && !state.SupportsAllDrives.IsUnknown() {
+		supportsAllDrives = state.SupportsAllDrives.ValueBool()
+	}
+
+	resp.Diagnostics.Append(r.archiveForm(ctx, state, supportsAllDrives)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "deleting Google Form", map[string]interface{}{
-		"form_id": formID,
+		"form_id":         formID,
+		"deletion_policy": policy,
 	})
 
-	// Delete the form via the Drive API. The Drive.Delete interface
-	// already treats 404 as success (returns nil), but we guard
-	// against implementations that may not.
-	err := r.client.Drive.Delete(ctx, formID)
+	// Trash or delete the form via the Drive API. Both calls already treat
+	// 404 as success (return nil), but we guard against implementations
+	// that may not.
+	var err error
+	if policy == "delete" {
+		err = r.client.Drive.Delete(ctx, formID)
+	} else {
+		err = r.client.Drive.Trash(ctx, formID, supportsAllDrives)
+	}
 	if err != nil {
 		if client.IsNotFound(err) {
 			tflog.Warn(ctx, "Google Form already deleted", map[string]interface{}{
@@ -50,7 +89,8 @@ func (r *FormResource) Delete(
 	}
 
 	tflog.Info(ctx, "deleted Google Form", map[string]interface{}{
-		"form_id": formID,
+		"form_id":         formID,
+		"deletion_policy": policy,
 	})
 
 	// State is automatically removed by the framework after Delete returns
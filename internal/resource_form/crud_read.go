@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	forms "google.golang.org/api/forms/v1"
 
 	"github.com/45ck/terraform-provider-googleforms/internal/client"
 	"github.com/45ck/terraform-provider-googleforms/internal/convert"
@@ -22,7 +23,7 @@ func (r *FormResource) Read(
 	resp *resource.ReadResponse,
 ) {
 	var state FormResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(getFormModel(ctx, req.State, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -57,9 +58,48 @@ func (r *FormResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Items the state does not track yet are reported in unmanaged_items.
+	imported := justImported(ctx, req.Private)
+	known, diags := knownItemIDs(ctx, state, imported)
+	resp.Diagnostics.Append(diags...)
+	if imported && resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImported, nil)...)
+	}
+
+	supportsAllDrives := false
+	if !state.SupportsAllDrives.IsNull() && !state.SupportsAllDrives.IsUnknown() {
+		supportsAllDrives = state.SupportsAllDrives.ValueBool()
+	}
+
+	// Step 3b: After an import there are no items in state; recover the
+	// item_keys saved on the Drive file at the last apply, if any.
+	if keyMap == nil && state.ContentJSON.IsNull() && state.Items.IsNull() {
+		keyMap = r.loadItemKeyProperties(ctx, formID, supportsAllDrives)
+	}
+
+	// Step 3c: Apply item_key options given in the import ID.
+	importOpts, diags := importItemKeysFromPrivate(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if importOpts != nil {
+		keyMap, diags = mergeImportItemKeys(keyMap, importOpts.Keys, form)
+		resp.Diagnostics.Append(diags...)
+		if resp.Private != nil {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImportItemKeys, nil)...)
+		}
+	}
 
 	// Step 4: Convert API response to convert.FormModel.
-	formModel, err := convert.FormToModel(form, keyMap)
+	readOpts, optDiags := buildFormToModelOptions(ctx, state.Items)
+	resp.Diagnostics.Append(optDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	readOpts.SlugKeys = importOpts != nil && importOpts.Slug
+
+	formModel, err := convert.FormToModelWithOptions(form, keyMap, readOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Form Response",
@@ -68,10 +108,17 @@ func (r *FormResource) Read(
 		return
 	}
 
-	// In partial management mode, only keep items that are already tracked in
-	// state (keyMap). Unmanaged items remain in the form but are ignored by TF.
-	if state.ManageMode.ValueString() == "partial" {
-		formModel.Items = filterItemsByKeyMap(formModel.Items, keyMap)
+	// In partial management mode, and with unmanaged_item_policy "keep" or
+	// "fail", only keep items that are already tracked in state (keyMap).
+	// Unmanaged items remain in the form but are ignored by TF. Right after
+	// an import every item is adopted.
+	if keepsUnmanagedItems(state) && known != nil {
+		tracked := keyMap
+		if tracked == nil && known != nil {
+			// An empty item list tracks no items.
+			tracked = map[string]string{}
+		}
+		formModel.Items = filterItemsByKeyMap(formModel.Items, tracked)
 	}
 
 	// Preserve input-only fields that may not be returned by the API.
@@ -83,12 +130,14 @@ func (r *FormResource) Read(
 
 	// Step 5: Map to Terraform state, preserving plan/config values.
 	newState := convertFormModelToTFState(formModel, state)
+	// Terraform requires an apply to store the planned operations, so a
+	// refresh keeps them rather than reporting a change no apply caused.
+	newState.PlannedItemOperations = plannedItemOperationsForState(state.PlannedItemOperations)
+	unmanagedItems, diags := unmanagedItemsValue(findUnmanagedItems(form, known))
+	resp.Diagnostics.Append(diags...)
+	newState.UnmanagedItems = unmanagedItems
 
 	// Best-effort: record current Drive parents.
-	supportsAllDrives := false
-	if !state.SupportsAllDrives.IsNull() && !state.SupportsAllDrives.IsUnknown() {
-		supportsAllDrives = state.SupportsAllDrives.ValueBool()
-	}
 	if parents, err := r.client.Drive.GetParents(ctx, formID, supportsAllDrives); err == nil {
 		lv, diags := types.ListValueFrom(ctx, types.StringType, parents)
 		resp.Diagnostics.Append(diags...)
@@ -111,8 +160,34 @@ func (r *FormResource) Read(
 		// Drift detection is hash-based via the plan modifier.
 		newState.ContentJSON = state.ContentJSON
 		newState.Items = state.Items
+		// The next apply re-creates content_json items deleted outside
+		// Terraform, so their recorded IDs are no longer valid.
+		if !contentJSONItemIDsPresent(ctx, state.ContentJSONItemIDs, form) {
+			newState.ContentJSONItemIDs = types.ListNull(types.StringType)
+		}
 	}
 
 	// Step 7: Save to state.
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setFormState(ctx, &resp.State, newState)...)
+}
+
+// contentJSONItemIDsPresent reports whether every recorded content_json item
+// ID still exists in the form.
+func contentJSONItemIDsPresent(ctx context.Context, ids types.List, f *forms.Form) bool {
+	stored, diags := contentJSONItemIDsFromTF(ctx, ids)
+	if diags.HasError() {
+		return false
+	}
+	live := make(map[string]bool, len(f.Items))
+	for _, it := range f.Items {
+		if it != nil {
+			live[it.ItemId] = true
+		}
+	}
+	for _, id := range stored {
+		if id == "" || !live[id] {
+			return false
+		}
+	}
+	return true
 }
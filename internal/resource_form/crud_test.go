@@ -6,15 +6,20 @@ package resourceform
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	drive "google.golang.org/api/drive/v3"
 	forms "google.golang.org/api/forms/v1"
 
 	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
 	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
 )
 
@@ -26,8 +31,9 @@ import (
 func testResource(formsAPI client.FormsAPI, driveAPI client.DriveAPI) *FormResource {
 	return &FormResource{
 		client: &client.Client{
-			Forms: formsAPI,
-			Drive: driveAPI,
+			Forms:         formsAPI,
+			Drive:         driveAPI,
+			ReadResponses: true,
 		},
 	}
 }
@@ -416,6 +422,187 @@ func TestCreate_BatchUpdateError_PartialStateSaved(t *testing.T) {
 	}
 }
 
+func TestCreate_ChunkedBatchError_SavesCreatedItems(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	mockForms := &testutil.MockFormsAPI{
+		CreateFunc: func(_ context.Context, form *forms.Form) (*forms.Form, error) {
+			return &forms.Form{FormId: "chunked-form", Info: form.Info}, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			calls++
+			if calls > 1 {
+				return nil, &client.APIError{StatusCode: 400, Message: "request too large"}
+			}
+			replies := make([]*forms.Response, len(req.Requests))
+			for i, r := range req.Requests {
+				replies[i] = &forms.Response{}
+				if r.CreateItem != nil {
+					replies[i].CreateItem = &forms.CreateItemResponse{ItemId: "gid_q1"}
+				}
+			}
+			return &forms.BatchUpdateFormResponse{Replies: replies}, nil
+		},
+	}
+
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+	ctx := context.Background()
+
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Chunked Form"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"batch_chunk_size":    tftypes.NewValue(tftypes.Number, 1),
+		"item": itemListVal(t,
+			saItem(t, "q1", "Name?", nil),
+			saItem(t, "q2", "Email?", nil),
+		),
+	})
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	initPrivate(&resp.Private)
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	// The create is not an error, so that Terraform does not taint the form
+	// and the next apply resumes it.
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Form Create Incomplete" {
+		t.Fatalf("expected a Form Create Incomplete warning, got %v", resp.Diagnostics.Warnings())
+	}
+	if calls != 2 {
+		t.Fatalf("BatchUpdate calls = %d, want 2", calls)
+	}
+	if incomplete, _ := resp.Private.GetKey(ctx, privateKeyIncompleteApply); len(incomplete) == 0 {
+		t.Error("incomplete_item_apply is not set")
+	}
+
+	// The state matches the plan; q2 was not created and has no ID.
+	var got FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	var items []ItemModel
+	resp.Diagnostics.Append(got.Items.ElementsAs(ctx, &items, false)...)
+	if len(items) != 2 || items[0].GoogleItemID.ValueString() != "gid_q1" || !items[1].GoogleItemID.IsNull() {
+		t.Fatalf("state items = %+v, want q1 with gid_q1 and q2 without ID", items)
+	}
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Error("state holds unknown values")
+	}
+}
+
+func TestUpdate_Targeted_ChunkedBatchError_SavesCreatedItems(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			f := basicFormResponse(formID, "Chunked Form")
+			f.Items = []*forms.Item{{
+				ItemId: "gid_q1",
+				Title:  "Name?",
+				QuestionItem: &forms.QuestionItem{
+					Question: &forms.Question{TextQuestion: &forms.TextQuestion{}},
+				},
+			}}
+			return f, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			calls++
+			if calls > 1 {
+				return nil, &client.APIError{StatusCode: 400, Message: "request too large"}
+			}
+			replies := make([]*forms.Response, len(req.Requests))
+			for i, r := range req.Requests {
+				replies[i] = &forms.Response{}
+				if r.CreateItem != nil {
+					replies[i].CreateItem = &forms.CreateItemResponse{ItemId: "gid_q2"}
+				}
+			}
+			return &forms.BatchUpdateFormResponse{Replies: replies}, nil
+		},
+	}
+
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+	ctx := context.Background()
+
+	vals := func(items ...tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"id":                  tftypes.NewValue(tftypes.String, "chunked-form"),
+			"title":               tftypes.NewValue(tftypes.String, "Chunked Form"),
+			"published":           tftypes.NewValue(tftypes.Bool, false),
+			"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+			"quiz":                tftypes.NewValue(tftypes.Bool, false),
+			"update_strategy":     tftypes.NewValue(tftypes.String, "targeted"),
+			"batch_chunk_size":    tftypes.NewValue(tftypes.Number, 1),
+			"item":                itemListVal(t, items...),
+		}
+	}
+	state := buildState(t, vals(withGoogleItemID(t, saItem(t, "q1", "Name?", nil), "gid_q1")))
+	plan := buildPlan(t, vals(
+		saItem(t, "q1", "Name?", nil),
+		saItem(t, "q2", "Email?", nil),
+		saItem(t, "q3", "Phone?", nil),
+	))
+
+	resp := &resource.UpdateResponse{State: state}
+	initPrivate(&resp.Private)
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected error diagnostic when the second chunk fails")
+	}
+	if calls != 2 {
+		t.Fatalf("BatchUpdate calls = %d, want 2", calls)
+	}
+	if incomplete, _ := resp.Private.GetKey(ctx, privateKeyIncompleteApply); len(incomplete) == 0 {
+		t.Error("incomplete_item_apply is not set")
+	}
+
+	var got FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	var items []ItemModel
+	resp.Diagnostics.Append(got.Items.ElementsAs(ctx, &items, false)...)
+	var saved []string
+	for _, it := range items {
+		saved = append(saved, it.ItemKey.ValueString()+"="+it.GoogleItemID.ValueString())
+	}
+	if strings.Join(saved, ",") != "q1=gid_q1,q2=gid_q2" {
+		t.Fatalf("state items = %v, want q1=gid_q1,q2=gid_q2", saved)
+	}
+}
+
+func TestPlanResume(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	vals := map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "chunked-form"),
+		"title":               tftypes.NewValue(tftypes.String, "Chunked Form"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+		"revision_id":         tftypes.NewValue(tftypes.String, "rev-1"),
+	}
+	state := buildState(t, vals)
+
+	for _, incomplete := range []bool{false, true} {
+		private := fakePrivate{}
+		if incomplete {
+			private[privateKeyIncompleteApply] = []byte("true")
+		}
+		got := buildPlan(t, vals)
+		if diags := planResume(ctx, private, state, &got); diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		var revision types.String
+		got.GetAttribute(ctx, path.Root("revision_id"), &revision)
+		if revision.IsUnknown() != incomplete {
+			t.Errorf("incomplete = %v: revision_id = %s", incomplete, revision)
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Read tests
 // ---------------------------------------------------------------------------
@@ -460,6 +647,48 @@ func TestRead_ExistingForm_PopulatesState(t *testing.T) {
 	}
 }
 
+func TestRead_KeepsPlannedItemOperations(t *testing.T) {
+	t.Parallel()
+
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			return basicFormResponse(formID, "Existing Form"), nil
+		},
+	}
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+	ctx := context.Background()
+
+	opsType := testSchemaResp().Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["planned_item_operations"].(tftypes.List)
+	opType := opsType.ElementType.(tftypes.Object)
+	applied := tftypes.NewValue(opsType, []tftypes.Value{
+		tftypes.NewValue(opType, map[string]tftypes.Value{
+			"operation":      tftypes.NewValue(tftypes.String, itemOpCreate),
+			"item_key":       tftypes.NewValue(tftypes.String, "q1"),
+			"google_item_id": tftypes.NewValue(tftypes.String, nil),
+			"index":          tftypes.NewValue(tftypes.Number, 0),
+		}),
+	})
+	state := buildState(t, map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, "existing-form-id"),
+		"title":                   tftypes.NewValue(tftypes.String, "Existing Form"),
+		"planned_item_operations": applied,
+	})
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+
+	// The apply had to store the planned operations; a refresh must not
+	// change them.
+	var ops []PlannedItemOperationModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("planned_item_operations"), &ops)...)
+	if len(ops) != 1 || ops[0].ItemKey.ValueString() != "q1" {
+		t.Errorf("planned_item_operations = %+v, want the create of q1", ops)
+	}
+}
+
 func TestRead_FormNotFound_RemovesFromState(t *testing.T) {
 	t.Parallel()
 
@@ -696,6 +925,8 @@ func TestUpdate_ItemsReplaced_Success(t *testing.T) {
 		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
 		"quiz":                tftypes.NewValue(tftypes.Bool, false),
 		"item":                itemListVal(t, saItem(t, "q_new", "New Q?", nil)),
+		// The state does not record the ID of the old item.
+		"unmanaged_item_policy": tftypes.NewValue(tftypes.String, "delete"),
 	})
 
 	resp := &resource.UpdateResponse{
@@ -737,11 +968,15 @@ func TestUpdate_ItemsReplaced_Success(t *testing.T) {
 func TestDelete_Success(t *testing.T) {
 	t.Parallel()
 
-	var deletedID string
+	var trashedID string
 	mockForms := &testutil.MockFormsAPI{}
 	mockDrive := &testutil.MockDriveAPI{
-		DeleteFunc: func(_ context.Context, fileID string) error {
-			deletedID = fileID
+		TrashFunc: func(_ context.Context, fileID string, _ bool) error {
+			trashedID = fileID
+			return nil
+		},
+		DeleteFunc: func(_ context.Context, _ string) error {
+			t.Error("Drive.Delete should not be called with the default deletion_policy")
 			return nil
 		},
 	}
@@ -770,17 +1005,83 @@ func TestDelete_Success(t *testing.T) {
 		t.Fatal("expected no errors during Delete")
 	}
 
+	if trashedID != "delete-form-id" {
+		t.Fatalf("expected Drive.Trash called with %q, got %q", "delete-form-id", trashedID)
+	}
+}
+
+func TestDelete_DeletionPolicyDelete_DeletesPermanently(t *testing.T) {
+	t.Parallel()
+
+	var deletedID string
+	mockDrive := &testutil.MockDriveAPI{
+		DeleteFunc: func(_ context.Context, fileID string) error {
+			deletedID = fileID
+			return nil
+		},
+		TrashFunc: func(_ context.Context, _ string, _ bool) error {
+			t.Error("Drive.Trash should not be called with deletion_policy = \"delete\"")
+			return nil
+		},
+	}
+
+	r := testResource(&testutil.MockFormsAPI{}, mockDrive)
+	ctx := context.Background()
+
+	state := buildState(t, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "delete-form-id"),
+		"title":           tftypes.NewValue(tftypes.String, "Delete Me"),
+		"deletion_policy": tftypes.NewValue(tftypes.String, "delete"),
+	})
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
 	if deletedID != "delete-form-id" {
 		t.Fatalf("expected Drive.Delete called with %q, got %q", "delete-form-id", deletedID)
 	}
 }
 
+func TestDelete_DeletionPolicyAbandon_LeavesForm(t *testing.T) {
+	t.Parallel()
+
+	mockDrive := &testutil.MockDriveAPI{
+		DeleteFunc: func(_ context.Context, _ string) error {
+			t.Error("Drive.Delete should not be called with deletion_policy = \"abandon\"")
+			return nil
+		},
+		TrashFunc: func(_ context.Context, _ string, _ bool) error {
+			t.Error("Drive.Trash should not be called with deletion_policy = \"abandon\"")
+			return nil
+		},
+	}
+
+	r := testResource(&testutil.MockFormsAPI{}, mockDrive)
+	ctx := context.Background()
+
+	state := buildState(t, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "abandoned-form-id"),
+		"title":           tftypes.NewValue(tftypes.String, "Keep Me"),
+		"deletion_policy": tftypes.NewValue(tftypes.String, "abandon"),
+	})
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+}
+
 func TestDelete_NotFound_NoError(t *testing.T) {
 	t.Parallel()
 
 	mockForms := &testutil.MockFormsAPI{}
 	mockDrive := &testutil.MockDriveAPI{
-		DeleteFunc: func(_ context.Context, fileID string) error {
+		TrashFunc: func(_ context.Context, fileID string, _ bool) error {
 			return &client.NotFoundError{
 				Resource: "Form",
 				ID:       fileID,
@@ -815,7 +1116,7 @@ func TestDelete_APIError_ReturnsDiagnostic(t *testing.T) {
 
 	mockForms := &testutil.MockFormsAPI{}
 	mockDrive := &testutil.MockDriveAPI{
-		DeleteFunc: func(_ context.Context, _ string) error {
+		TrashFunc: func(_ context.Context, _ string, _ bool) error {
 			return &client.APIError{
 				StatusCode: 500,
 				Message:    "drive server error",
@@ -1115,6 +1416,73 @@ func TestRead_WithItems_CorrectMapping(t *testing.T) {
 	}
 }
 
+func TestRead_WithRawJSONItem_KeepsConfiguredJSON(t *testing.T) {
+	t.Parallel()
+
+	live := formWithItems("raw-read-id", "Raw Read Form")
+	live.Items[1].QuestionItem.Question.ChoiceQuestion.Options[0].Image = &forms.Image{
+		SourceUri:  "https://example.com/red.png",
+		ContentUri: "https://lh3.example/red",
+	}
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, _ string) (*forms.Form, error) {
+			return live, nil
+		},
+	}
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+	ctx := context.Background()
+
+	configured := `{
+  "title": "Color?",
+  "questionItem": {"question": {"choiceQuestion": {"type": "RADIO", "options": [
+    {"value": "Red", "image": {"sourceUri": "https://example.com/red.png"}},
+    {"value": "Blue"}
+  ]}}}
+}`
+
+	iType := itemBlockType(t)
+	rawType, ok := iType.AttributeTypes["raw_json"].(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected raw_json to be tftypes.Object, got %T", iType.AttributeTypes["raw_json"])
+	}
+	item := newItemVal(iType, "color", map[string]tftypes.Value{
+		"google_item_id": tftypes.NewValue(tftypes.String, "gid_2"),
+		"raw_json": newObjectValue(rawType, map[string]tftypes.Value{
+			"json": tftypes.NewValue(tftypes.String, configured),
+		}),
+	})
+
+	state := buildState(t, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "raw-read-id"),
+		"title":       tftypes.NewValue(tftypes.String, "Raw Read Form"),
+		"manage_mode": tftypes.NewValue(tftypes.String, "partial"),
+		"item":        itemListVal(t, item),
+	})
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+
+	var model FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	var items []ItemModel
+	resp.Diagnostics.Append(model.Items.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read state: %v", resp.Diagnostics.Errors())
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 managed item, got %d", len(items))
+	}
+	if items[0].RawJSON == nil || items[0].MultipleChoice != nil {
+		t.Fatalf("expected item to be read back as raw_json, got %+v", items[0])
+	}
+	if got := items[0].RawJSON.JSON.ValueString(); got != configured {
+		t.Errorf("expected configured JSON to be kept, got %s", got)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Additional Update tests
 // ---------------------------------------------------------------------------
@@ -1404,6 +1772,8 @@ func TestUpdate_WithContentJSON_Success(t *testing.T) {
 		"published":           tftypes.NewValue(tftypes.Bool, false),
 		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
 		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		// The state tracks none of the form's items.
+		"unmanaged_item_policy": tftypes.NewValue(tftypes.String, "delete"),
 	})
 
 	resp := &resource.UpdateResponse{
@@ -1424,6 +1794,108 @@ func TestUpdate_WithContentJSON_Success(t *testing.T) {
 	}
 }
 
+func TestUpdate_WithContentJSON_Targeted_PreservesItemIDs(t *testing.T) {
+	t.Parallel()
+
+	contentJSON := `[
+		{"title":"Q1 renamed","questionItem":{"question":{"textQuestion":{"paragraph":false}}}},
+		{"title":"Q2","questionItem":{"question":{"textQuestion":{"paragraph":true}}}}
+	]`
+	liveItem := &forms.Item{
+		ItemId: "old_gid",
+		Title:  "Q1",
+		QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{
+				QuestionId:   "old_qid",
+				TextQuestion: &forms.TextQuestion{Paragraph: false},
+			},
+		},
+	}
+
+	var sent []*forms.Request
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			f := basicFormResponse(formID, "JSON Targeted Form")
+			f.Items = []*forms.Item{liveItem}
+			return f, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			sent = req.Requests
+			replies := make([]*forms.Response, len(req.Requests))
+			for i, r := range req.Requests {
+				if r.CreateItem != nil {
+					replies[i] = &forms.Response{CreateItem: &forms.CreateItemResponse{ItemId: "new_gid"}}
+				} else {
+					replies[i] = &forms.Response{}
+				}
+			}
+			return &forms.BatchUpdateFormResponse{Replies: replies}, nil
+		},
+	}
+
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+	ctx := context.Background()
+
+	idsType := tftypes.List{ElementType: tftypes.String}
+	state := buildState(t, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "json-targeted-id"),
+		"title":               tftypes.NewValue(tftypes.String, "JSON Targeted Form"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"update_strategy":     tftypes.NewValue(tftypes.String, "targeted"),
+		"content_json":        tftypes.NewValue(tftypes.String, `[{"title":"Q1","questionItem":{"question":{"textQuestion":{"paragraph":false}}}}]`),
+		"content_json_item_ids": tftypes.NewValue(idsType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "old_gid"),
+		}),
+	})
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "json-targeted-id"),
+		"title":               tftypes.NewValue(tftypes.String, "JSON Targeted Form"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"update_strategy":     tftypes.NewValue(tftypes.String, "targeted"),
+		"content_json":        tftypes.NewValue(tftypes.String, contentJSON),
+	})
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		for _, d := range resp.Diagnostics.Errors() {
+			t.Logf("  diagnostic: %s -- %s", d.Summary(), d.Detail())
+		}
+		t.Fatal("expected no errors during targeted Update with content_json")
+	}
+
+	var updates, creates, deletes int
+	for _, req := range sent {
+		switch {
+		case req.UpdateItem != nil:
+			updates++
+			if req.UpdateItem.Item.ItemId != "old_gid" {
+				t.Errorf("update item ID = %q, want old_gid", req.UpdateItem.Item.ItemId)
+			}
+		case req.CreateItem != nil:
+			creates++
+		case req.DeleteItem != nil:
+			deletes++
+		}
+	}
+	if updates != 1 || creates != 1 || deletes != 0 {
+		t.Fatalf("updates=%d creates=%d deletes=%d, want 1/1/0", updates, creates, deletes)
+	}
+
+	var got FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	var ids []string
+	resp.Diagnostics.Append(got.ContentJSONItemIDs.ElementsAs(ctx, &ids, false)...)
+	if len(ids) != 2 || ids[0] != "old_gid" || ids[1] != "new_gid" {
+		t.Errorf("content_json_item_ids = %v, want [old_gid new_gid]", ids)
+	}
+}
+
 func TestCreate_PublishSettingsError_ReturnsDiagnostic(t *testing.T) {
 	t.Parallel()
 
@@ -1457,6 +1929,12 @@ func TestCreate_PublishSettingsError_ReturnsDiagnostic(t *testing.T) {
 		"published":           tftypes.NewValue(tftypes.Bool, true),
 		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
 		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		// Computed values are unknown during a real create.
+		"id":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"responder_uri":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"revision_id":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email_collection_type": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"parent_ids":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
 	})
 
 	resp := &resource.CreateResponse{
@@ -1479,4 +1957,327 @@ func TestCreate_PublishSettingsError_ReturnsDiagnostic(t *testing.T) {
 	if !found {
 		t.Fatal("expected diagnostic with summary containing 'Error Setting Publish Settings'")
 	}
+
+	// The partial state must be fully known so Terraform can save it.
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Fatal("expected partial state without unknown values")
+	}
+	var got FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueString() == "" {
+		t.Fatal("expected form ID in partial state")
+	}
+	if got.Published.ValueBool() {
+		t.Error("expected published = false in partial state because publishing failed")
+	}
+}
+
+func TestCreate_WithItems_SavesItemKeyProperties(t *testing.T) {
+	t.Parallel()
+
+	mockForms := &testutil.MockFormsAPI{
+		CreateFunc: func(_ context.Context, form *forms.Form) (*forms.Form, error) {
+			return &forms.Form{FormId: "keys-form", Info: form.Info}, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			out := &forms.BatchUpdateFormResponse{}
+			n := 0
+			for _, r := range req.Requests {
+				reply := &forms.Response{}
+				if r.CreateItem != nil {
+					n++
+					reply.CreateItem = &forms.CreateItemResponse{ItemId: fmt.Sprintf("gid_%d", n)}
+				}
+				out.Replies = append(out.Replies, reply)
+			}
+			return out, nil
+		},
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			return formWithItems(formID, "Keys Form"), nil
+		},
+	}
+	var saved map[string]string
+	mockDrive := &testutil.MockDriveAPI{
+		UpdateAppPropertiesFunc: func(_ context.Context, _ string, set map[string]string, _ []string, _ bool) error {
+			saved = set
+			return nil
+		},
+	}
+
+	r := testResource(mockForms, mockDrive)
+	ctx := context.Background()
+
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Keys Form"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"item": itemListVal(t,
+			saItem(t, "name", "Name?", nil),
+			mcItem(t, "color", "Color?", []string{"Red", "Blue"}, nil),
+		),
+	})
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	got := convert.DecodeItemKeyProperties(saved)
+	want := map[string]string{"gid_1": "name", "gid_2": "color"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("saved item_key mapping = %v, want %v", got, want)
+	}
+}
+
+func TestRead_AfterImport_RecoversItemKeys(t *testing.T) {
+	t.Parallel()
+
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			return formWithItems(formID, "Imported Form"), nil
+		},
+	}
+	props, err := convert.EncodeItemKeyProperties(map[string]string{"gid_2": "color"})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	mockDrive := &testutil.MockDriveAPI{
+		GetAppPropertiesFunc: func(_ context.Context, _ string, _ bool) (map[string]string, error) {
+			return props, nil
+		},
+	}
+
+	r := testResource(mockForms, mockDrive)
+	ctx := context.Background()
+
+	// State as left by ImportState: only the ID is set.
+	imported := importForm(t, r, "imported-id")
+	resp := &resource.ReadResponse{State: imported.State, Private: imported.Private}
+	r.Read(ctx, resource.ReadRequest{State: imported.State, Private: imported.Private}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	var model FormResourceModel
+	if diags := resp.State.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("failed to read state model: %v", diags.Errors())
+	}
+	var items []ItemModel
+	if diags := model.Items.ElementsAs(ctx, &items, false); diags.HasError() {
+		t.Fatalf("failed to read items: %v", diags.Errors())
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if got := items[0].ItemKey.ValueString(); got != "item_0" {
+		t.Errorf("item 0 key = %q, want item_0", got)
+	}
+	if got := items[1].ItemKey.ValueString(); got != "color" {
+		t.Errorf("item 1 key = %q, want color", got)
+	}
+}
+
+func TestCreate_WithSourceFormID_AdoptsCopiedItems(t *testing.T) {
+	t.Parallel()
+
+	copied := &forms.Form{
+		FormId: "copy-id",
+		Info:   &forms.Info{Title: "Template"},
+		Items: []*forms.Item{
+			{ItemId: "gid_1", Title: "Name?", QuestionItem: &forms.QuestionItem{Question: &forms.Question{TextQuestion: &forms.TextQuestion{}}}},
+			{ItemId: "gid_9", Title: "Extra", QuestionItem: &forms.QuestionItem{Question: &forms.Question{TextQuestion: &forms.TextQuestion{Paragraph: true}}}},
+		},
+	}
+	var gets int
+	var sent []*forms.Request
+	mockForms := &testutil.MockFormsAPI{
+		CreateFunc: func(_ context.Context, _ *forms.Form) (*forms.Form, error) {
+			t.Fatal("Forms.Create must not be called when copying")
+			return nil, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			sent = append(sent, req.Requests...)
+			out := &forms.BatchUpdateFormResponse{}
+			for _, r := range req.Requests {
+				reply := &forms.Response{}
+				if r.CreateItem != nil {
+					reply.CreateItem = &forms.CreateItemResponse{ItemId: "gid_2"}
+				}
+				out.Replies = append(out.Replies, reply)
+			}
+			return out, nil
+		},
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			gets++
+			if gets == 1 {
+				return copied, nil
+			}
+			return formWithItems(formID, "Copied Form"), nil
+		},
+	}
+	var copiedFrom string
+	mockDrive := &testutil.MockDriveAPI{
+		CopyFileFunc: func(_ context.Context, fileID string, f *drive.File, _ bool) (*drive.File, error) {
+			copiedFrom = fileID
+			return &drive.File{Id: "copy-id", Name: f.Name}, nil
+		},
+	}
+
+	r := testResource(mockForms, mockDrive)
+	ctx := context.Background()
+
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Copied Form"),
+		"source_form_id":      tftypes.NewValue(tftypes.String, "template-id"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"manage_mode":         tftypes.NewValue(tftypes.String, "all"),
+		"item": itemListVal(t,
+			saItem(t, "name", "Name?", nil),
+			mcItem(t, "color", "Color?", []string{"Red", "Blue"}, nil),
+		),
+	})
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if copiedFrom != "template-id" {
+		t.Errorf("copied file = %q, want template-id", copiedFrom)
+	}
+
+	var deletes, creates int
+	for _, req := range sent {
+		if req.DeleteItem != nil {
+			deletes++
+			if req.DeleteItem.Location.Index != 1 {
+				t.Errorf("delete index = %d, want 1 (the unadopted item)", req.DeleteItem.Location.Index)
+			}
+		}
+		if req.CreateItem != nil {
+			creates++
+		}
+	}
+	if deletes != 1 || creates != 1 {
+		t.Errorf("got %d deletes and %d creates, want 1 and 1", deletes, creates)
+	}
+
+	var model FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	if model.ID.ValueString() != "copy-id" {
+		t.Errorf("id = %q, want copy-id", model.ID.ValueString())
+	}
+	var items []ItemModel
+	resp.Diagnostics.Append(model.Items.ElementsAs(ctx, &items, false)...)
+	if len(items) != 2 || items[0].GoogleItemID.ValueString() != "gid_1" || items[1].GoogleItemID.ValueString() != "gid_2" {
+		t.Fatalf("items = %+v, want name=gid_1 and color=gid_2", items)
+	}
+	if items[0].ItemKey.ValueString() != "name" || items[1].ItemKey.ValueString() != "color" {
+		t.Errorf("item keys = %q, %q", items[0].ItemKey.ValueString(), items[1].ItemKey.ValueString())
+	}
+}
+
+func TestCreate_WithSourceFormID_DisablesQuizAfterGradedItems(t *testing.T) {
+	t.Parallel()
+
+	copied := &forms.Form{
+		FormId:   "copy-id",
+		Info:     &forms.Info{Title: "Quiz Template"},
+		Settings: &forms.FormSettings{QuizSettings: &forms.QuizSettings{IsQuiz: true}},
+		Items: []*forms.Item{
+			{ItemId: "gid_1", Title: "Name?", QuestionItem: &forms.QuestionItem{Question: &forms.Question{TextQuestion: &forms.TextQuestion{}}}},
+			{ItemId: "gid_9", Title: "Capital?", QuestionItem: &forms.QuestionItem{Question: &forms.Question{
+				TextQuestion: &forms.TextQuestion{},
+				Grading:      &forms.Grading{PointValue: 1, CorrectAnswers: &forms.CorrectAnswers{Answers: []*forms.CorrectAnswer{{Value: "Paris"}}}},
+			}}},
+		},
+	}
+	var sent []*forms.Request
+	mockForms := &testutil.MockFormsAPI{
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			sent = append(sent, req.Requests...)
+			return &forms.BatchUpdateFormResponse{Replies: make([]*forms.Response, len(req.Requests))}, nil
+		},
+		GetFunc: func(_ context.Context, _ string) (*forms.Form, error) {
+			return copied, nil
+		},
+	}
+	mockDrive := &testutil.MockDriveAPI{
+		CopyFileFunc: func(_ context.Context, _ string, f *drive.File, _ bool) (*drive.File, error) {
+			return &drive.File{Id: "copy-id", Name: f.Name}, nil
+		},
+	}
+	r := testResource(mockForms, mockDrive)
+
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Copied Form"),
+		"source_form_id":      tftypes.NewValue(tftypes.String, "quiz-template"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"manage_mode":         tftypes.NewValue(tftypes.String, "all"),
+		"item":                itemListVal(t, saItem(t, "name", "Name?", nil)),
+	})
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	deleteAt, quizAt := -1, -1
+	for i, req := range sent {
+		if req.DeleteItem != nil {
+			deleteAt = i
+		}
+		if req.UpdateSettings != nil && req.UpdateSettings.Settings.QuizSettings != nil {
+			if req.UpdateSettings.Settings.QuizSettings.IsQuiz {
+				t.Errorf("request %d enables quiz mode, want it disabled", i)
+			}
+			quizAt = i
+		}
+	}
+	if deleteAt < 0 || quizAt < 0 {
+		t.Fatalf("expected a delete of the graded item and a quiz settings request, got %d requests", len(sent))
+	}
+	if quizAt < deleteAt {
+		t.Errorf("quiz mode is disabled at request %d, before the graded item is deleted at request %d", quizAt, deleteAt)
+	}
+}
+
+func TestCreate_WithSourceFormIDAndContentJSON_Errors(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	mockForms := &testutil.MockFormsAPI{
+		CreateFunc: func(_ context.Context, _ *forms.Form) (*forms.Form, error) {
+			calls++
+			return &forms.Form{FormId: "new-id"}, nil
+		},
+	}
+	mockDrive := &testutil.MockDriveAPI{
+		CopyFileFunc: func(_ context.Context, _ string, _ *drive.File, _ bool) (*drive.File, error) {
+			calls++
+			return &drive.File{Id: "copy-id"}, nil
+		},
+	}
+	r := testResource(mockForms, mockDrive)
+
+	// The source form ID was unknown when the configuration was validated.
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Copied Form"),
+		"source_form_id":      tftypes.NewValue(tftypes.String, "template-id"),
+		"content_json":        tftypes.NewValue(tftypes.String, `[{"title":"Name?","questionItem":{"question":{"textQuestion":{}}}}]`),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+	})
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	expectErrorContains(t, resp.Diagnostics, `"content_json" and "source_form_id"`)
+	if calls != 0 {
+		t.Errorf("expected no form to be created, got %d calls", calls)
+	}
 }
@@ -5,6 +5,7 @@ package resourceform
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -25,8 +26,8 @@ func (r *FormResource) Update(
 ) {
 	var plan FormResourceModel
 	var state FormResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(getFormModel(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(getFormModel(ctx, req.State, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -36,10 +37,7 @@ func (r *FormResource) Update(
 		"form_id": formID,
 	})
 
-	updateStrategy := "replace_all"
-	if !plan.UpdateStrategy.IsNull() && !plan.UpdateStrategy.IsUnknown() && plan.UpdateStrategy.ValueString() != "" {
-		updateStrategy = plan.UpdateStrategy.ValueString()
-	}
+	updateStrategy := itemUpdateStrategy(plan, false)
 
 	manageMode := "all"
 	if !plan.ManageMode.IsNull() && !plan.ManageMode.IsUnknown() && plan.ManageMode.ValueString() != "" {
@@ -61,26 +59,76 @@ func (r *FormResource) Update(
 		return
 	}
 
+	conflictPolicy := "overwrite"
+	if !plan.ConflictPolicy.IsNull() && !plan.ConflictPolicy.IsUnknown() && plan.ConflictPolicy.ValueString() != "" {
+		conflictPolicy = plan.ConflictPolicy.ValueString()
+	}
+	if conflictPolicy == "fail_on_content_drift" {
+		lastApplied, d := req.Private.GetKey(ctx, privateKeyFormContent)
+		resp.Diagnostics.Append(d...)
+		resp.Diagnostics.Append(checkContentDrift(ctx, state, lastApplied, currentForm)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// A previous chunked apply stopped part-way: state holds the items created
+	// so far, so finish with targeted requests instead of starting over.
+	incomplete, d := req.Private.GetKey(ctx, privateKeyIncompleteApply)
+	resp.Diagnostics.Append(d...)
+	resuming := len(incomplete) > 0
+	if resuming && updateStrategy == "replace_all" {
+		resp.Diagnostics.AddWarning(
+			"Resuming Incomplete Apply",
+			"A previous apply stopped after creating part of the items. The remaining items are created with targeted requests instead of replacing all items.",
+		)
+	}
+	updateStrategy = itemUpdateStrategy(plan, resuming)
+
+	// Items Terraform does not track, such as items added in the Forms
+	// editor, are only deleted with unmanaged_item_policy = "delete".
+	known, d := knownItemIDs(ctx, state, false)
+	resp.Diagnostics.Append(d...)
+	if unmanaged := findUnmanagedItems(currentForm, known); manageMode == "all" && len(unmanaged) > 0 {
+		switch unmanagedItemPolicy(plan) {
+		case "fail":
+			resp.Diagnostics.Append(unmanagedItemsError(unmanaged)...)
+			return
+		case "keep":
+			if updateStrategy == "replace_all" {
+				resp.Diagnostics.AddError(
+					"Invalid Configuration",
+					"unmanaged_item_policy = \"keep\" cannot be used with update_strategy = \"replace_all\" while the form has unmanaged items, because replace_all deletes every item. Use update_strategy = \"targeted\".",
+				)
+				return
+			}
+		}
+	}
+
+	// saveProgress records the items created so far after each chunk of a
+	// chunked apply.
+	saveProgress := func(progress FormResourceModel) {
+		resp.Diagnostics.Append(setFormState(ctx, &resp.State, progress)...)
+		if resp.Private != nil {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyIncompleteApply, []byte("true"))...)
+		}
+	}
+
 	// Step 2: Build and execute batch update requests.
 	// Always update title/description. Items are updated based on update_strategy.
 	var keyMap map[string]string
+	var contentJSONItemIDs []string
 	switch updateStrategy {
 	case "targeted":
-		if manageMode == "partial" && !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
-			resp.Diagnostics.AddError(
-				"Invalid Configuration",
-				"manage_mode = \"partial\" is not supported with content_json. Use item blocks for partial management.",
-			)
-			return
-		}
-		km, d := r.updateTargeted(ctx, plan, state, currentForm)
+		km, ids, d := r.updateTargeted(ctx, plan, state, currentForm, saveProgress)
 		resp.Diagnostics.Append(d...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		keyMap = km
+		contentJSONItemIDs = ids
 	case "replace_all":
-		if manageMode == "partial" && (plan.ContentJSON.IsNull() || plan.ContentJSON.IsUnknown() || plan.ContentJSON.ValueString() == "") {
+		if manageMode == "partial" {
 			resp.Diagnostics.AddError(
 				"Invalid Configuration",
 				"manage_mode = \"partial\" cannot be used with update_strategy = \"replace_all\" because it would delete unmanaged items. Use update_strategy = \"targeted\".",
@@ -94,12 +142,27 @@ func (r *FormResource) Update(
 			)
 		}
 
-		km, d := r.updateReplaceAll(ctx, plan, state, currentForm)
+		// Every existing item is deleted and re-created.
+		removed := make(map[string]bool, len(currentForm.Items))
+		for _, it := range currentForm.Items {
+			if it != nil && it.ItemId != "" {
+				removed[it.ItemId] = true
+			}
+		}
+		idToKey, d := buildItemKeyMap(ctx, state.Items)
+		resp.Diagnostics.Append(d...)
+		resp.Diagnostics.Append(r.guardItemResponseLoss(ctx, plan, formID, currentForm.Items, removed, idToKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		km, ids, d := r.updateReplaceAll(ctx, plan, state, currentForm, saveProgress)
 		resp.Diagnostics.Append(d...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		keyMap = km
+		contentJSONItemIDs = ids
 	default:
 		resp.Diagnostics.AddError("Invalid update_strategy", fmt.Sprintf("Unsupported update_strategy %q", updateStrategy))
 		return
@@ -111,7 +174,9 @@ func (r *FormResource) Update(
 	statePublished := state.Published.ValueBool()
 	stateAccepting := state.AcceptingResponses.ValueBool()
 
-	if planPublished != statePublished || planAccepting != stateAccepting {
+	// An incomplete create saved the planned publish settings and folder
+	// without applying them.
+	if resuming || planPublished != statePublished || planAccepting != stateAccepting {
 		tflog.Debug(ctx, "updating publish settings", map[string]interface{}{
 			"published":           planPublished,
 			"accepting_responses": planAccepting,
@@ -133,7 +198,7 @@ func (r *FormResource) Update(
 		supportsAllDrives = plan.SupportsAllDrives.ValueBool()
 	}
 	if !plan.FolderID.IsNull() && !plan.FolderID.IsUnknown() && plan.FolderID.ValueString() != "" {
-		needMove := state.FolderID.IsNull() || state.FolderID.IsUnknown() || state.FolderID.ValueString() != plan.FolderID.ValueString()
+		needMove := resuming || state.FolderID.IsNull() || state.FolderID.IsUnknown() || state.FolderID.ValueString() != plan.FolderID.ValueString()
 		if needMove {
 			if err := r.client.Drive.MoveToFolder(ctx, formID, plan.FolderID.ValueString(), supportsAllDrives); err != nil {
 				resp.Diagnostics.AddError("Move Form To Folder Failed", err.Error())
@@ -168,7 +233,13 @@ func (r *FormResource) Update(
 		keyMap = map[string]string{}
 	}
 
-	formModel, err := convert.FormToModel(finalForm, keyMap)
+	readOpts, optDiags := buildFormToModelOptions(ctx, plan.Items)
+	resp.Diagnostics.Append(optDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formModel, err := convert.FormToModelWithOptions(finalForm, keyMap, readOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Form Response",
@@ -177,7 +248,7 @@ func (r *FormResource) Update(
 		return
 	}
 
-	if manageMode == "partial" {
+	if keepsUnmanagedItems(plan) {
 		formModel.Items = filterItemsByKeyMap(formModel.Items, keyMap)
 	}
 
@@ -191,6 +262,13 @@ func (r *FormResource) Update(
 
 	// Step 8: Convert to TF state and save.
 	newState := convertFormModelToTFState(formModel, plan)
+	unmanagedItems, d := appliedUnmanagedItems(finalForm, formModel.Items, contentJSONItemIDs)
+	resp.Diagnostics.Append(d...)
+	newState.UnmanagedItems = unmanagedItems
+	// Record the content as applied for conflict_policy = "fail_on_content_drift".
+	hash, content, d := formContent(finalForm)
+	resp.Diagnostics.Append(d...)
+	newState.ContentHash = hash
 
 	if plan.ContentJSON.IsNull() || plan.ContentJSON.IsUnknown() || plan.ContentJSON.ValueString() == "" {
 		itemList, diags := convertItemsToTFList(ctx, formModel.Items)
@@ -201,9 +279,21 @@ func (r *FormResource) Update(
 		newState.Items = itemList
 	} else {
 		newState.Items = plan.Items
+		ids, diags := contentJSONItemIDsToTF(ctx, contentJSONItemIDs)
+		resp.Diagnostics.Append(diags...)
+		newState.ContentJSONItemIDs = ids
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	// Best-effort: record the item_key mapping on the Drive file so that an
+	// import can recover it.
+	resp.Diagnostics.Append(r.saveItemKeyProperties(ctx, formID, newState.Items, supportsAllDrives)...)
+
+	resp.Diagnostics.Append(setFormState(ctx, &resp.State, newState)...)
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyIncompleteApply, nil)...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyIncompleteCreate, nil)...)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyFormContent, content)...)
+	}
 }
 
 func (r *FormResource) updateReplaceAll(
@@ -211,7 +301,8 @@ func (r *FormResource) updateReplaceAll(
 	plan FormResourceModel,
 	state FormResourceModel,
 	currentForm *forms.Form,
-) (map[string]string, diag.Diagnostics) {
+	saveProgress func(progress FormResourceModel),
+) (map[string]string, []string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	createdKeyMap := map[string]string{}
 	var desiredItems []convert.ItemModel
@@ -226,7 +317,7 @@ func (r *FormResource) updateReplaceAll(
 				"Update Conflict Detected",
 				fmt.Sprintf("Form revision_id changed since last read (state=%s current=%s). Set conflict_policy = \"overwrite\" to force applying to the latest revision.", state.RevisionID.ValueString(), currentForm.RevisionId),
 			)
-			return nil, diags
+			return nil, nil, diags
 		}
 	} else if conflictPolicy == "fail" {
 		diags.AddWarning(
@@ -263,14 +354,14 @@ func (r *FormResource) updateReplaceAll(
 		jsonRequests, err := convert.DeclarativeJSONToRequests(plan.ContentJSON.ValueString())
 		if err != nil {
 			diags.AddError("Error Parsing content_json", fmt.Sprintf("Could not parse content_json: %s", err))
-			return nil, diags
+			return nil, nil, diags
 		}
 		createItemRequests = jsonRequests
 	} else {
 		convertItems, d := tfItemsToConvertItems(ctx, plan.Items)
 		diags.Append(d...)
 		if diags.HasError() {
-			return nil, diags
+			return nil, nil, diags
 		}
 		desiredItems = convertItems
 
@@ -289,7 +380,7 @@ func (r *FormResource) updateReplaceAll(
 				var planItems []ItemModel
 				diags.Append(plan.Items.ElementsAs(ctx, &planItems, false)...)
 				if diags.HasError() {
-					return nil, diags
+					return nil, nil, diags
 				}
 				createKeys = make([]string, len(planItems))
 				for i := range planItems {
@@ -299,7 +390,7 @@ func (r *FormResource) updateReplaceAll(
 				itemRequests, err := convert.ItemsToCreateRequests(convertItems)
 				if err != nil {
 					diags.AddError("Error Building Item Requests", fmt.Sprintf("Could not build item create requests: %s", err))
-					return nil, diags
+					return nil, nil, diags
 				}
 				createItemRequests = itemRequests
 			}
@@ -325,25 +416,48 @@ func (r *FormResource) updateReplaceAll(
 	requests = append(requests, createItemRequests...)
 
 	if len(requests) == 0 {
-		return createdKeyMap, diags
+		// Nothing changed, so the content_json items keep their IDs.
+		ids, d := contentJSONItemIDsFromTF(ctx, state.ContentJSONItemIDs)
+		diags.Append(d...)
+		return createdKeyMap, ids, diags
 	}
 
 	tflog.Debug(ctx, "executing batchUpdate (replace_all)", map[string]interface{}{
 		"request_count": len(requests),
 	})
 
-	batchReq := &forms.BatchUpdateFormRequest{
-		Requests:              requests,
-		IncludeFormInResponse: true,
-	}
+	var writeControl *forms.WriteControl
 	if conflictPolicy == "fail" && !state.RevisionID.IsNull() && !state.RevisionID.IsUnknown() && state.RevisionID.ValueString() != "" {
-		batchReq.WriteControl = &forms.WriteControl{RequiredRevisionId: state.RevisionID.ValueString()}
+		writeControl = &forms.WriteControl{RequiredRevisionId: state.RevisionID.ValueString()}
 	}
 
-	apiResp, err := r.client.Forms.BatchUpdate(ctx, state.ID.ValueString(), batchReq)
+	onChunk := func(done *forms.BatchUpdateFormResponse, sent int) {
+		ids, err := createdItemIDs(done, requests[:sent])
+		if err != nil || saveProgress == nil {
+			return
+		}
+		if progress, d := itemProgressState(ctx, state, plan, ids); !d.HasError() {
+			saveProgress(progress)
+		}
+	}
+	apiResp, sent, err := r.batchUpdateChunked(ctx, state.ID.ValueString(), requests, batchChunkSize(plan), writeControl, onChunk)
 	if err != nil {
-		diags.AddError("Error Updating Google Form", fmt.Sprintf("BatchUpdate failed for form %s: %s", state.ID.ValueString(), err))
-		return nil, diags
+		detail := fmt.Sprintf("BatchUpdate failed for form %s: %s", state.ID.ValueString(), err)
+		if sent > 0 {
+			detail += " Items created so far were saved to state; apply again to create the remaining items."
+		}
+		diags.AddError("Error Updating Google Form", detail)
+		return nil, nil, diags
+	}
+
+	// content_json: record the created item IDs by index for later targeted updates.
+	var contentJSONItemIDs []string
+	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
+		ids, derr := createdItemIDs(apiResp, requests)
+		if derr != nil {
+			diags.AddWarning("Item ID Correlation Failed", derr.Error())
+		}
+		contentJSONItemIDs = ids
 	}
 
 	// Build itemId -> item_key mapping for all created items (HCL item blocks only).
@@ -363,11 +477,11 @@ func (r *FormResource) updateReplaceAll(
 	if len(desiredItems) > 0 && len(createdKeyMap) > 0 {
 		if navDiags := r.applyChoiceNavigationUpdates(ctx, state.ID.ValueString(), desiredItems, createdKeyMap); navDiags.HasError() {
 			diags.Append(navDiags...)
-			return nil, diags
+			return nil, nil, diags
 		}
 	}
 
-	return createdKeyMap, diags
+	return createdKeyMap, contentJSONItemIDs, diags
 }
 
 func (r *FormResource) updateTargeted(
@@ -375,12 +489,16 @@ func (r *FormResource) updateTargeted(
 	plan FormResourceModel,
 	state FormResourceModel,
 	currentForm *forms.Form,
-) (map[string]string, diag.Diagnostics) {
+	saveProgress func(progress FormResourceModel),
+) (map[string]string, []string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	keyMap, d := buildItemKeyMap(ctx, state.Items)
 	diags.Append(d...)
 	if diags.HasError() {
-		return nil, diags
+		return nil, nil, diags
+	}
+	if keyMap == nil {
+		keyMap = map[string]string{}
 	}
 
 	conflictPolicy := "overwrite"
@@ -393,7 +511,7 @@ func (r *FormResource) updateTargeted(
 				"Update Conflict Detected",
 				fmt.Sprintf("Form revision_id changed since last read (state=%s current=%s). Set conflict_policy = \"overwrite\" to force applying to the latest revision.", state.RevisionID.ValueString(), currentForm.RevisionId),
 			)
-			return nil, diags
+			return nil, nil, diags
 		}
 	} else if conflictPolicy == "fail" {
 		diags.AddWarning(
@@ -402,58 +520,32 @@ func (r *FormResource) updateTargeted(
 		)
 	}
 
-	manageMode := "all"
-	if !plan.ManageMode.IsNull() && !plan.ManageMode.IsUnknown() && plan.ManageMode.ValueString() != "" {
-		manageMode = plan.ManageMode.ValueString()
-	}
-
-	partialNewItemPolicy := "append"
-	if !plan.PartialNewItemPolicy.IsNull() && !plan.PartialNewItemPolicy.IsUnknown() && plan.PartialNewItemPolicy.ValueString() != "" {
-		partialNewItemPolicy = plan.PartialNewItemPolicy.ValueString()
-	}
-
-	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
-		diags.AddError("Targeted Updates Not Supported With content_json", "content_json mode cannot be updated in-place. Set update_strategy = \"replace_all\" or switch to item blocks.")
-		return nil, diags
-	}
-
 	if currentForm == nil {
 		diags.AddError("Error Reading Current Form", "Current form payload is nil; targeted update requires a readable form document.")
-		return nil, diags
+		return nil, nil, diags
 	}
 
-	// Decode plan/state items to correlate item_keys and detect adds/removes/reorders.
-	var planItems []ItemModel
-	var stateItems []ItemModel
-
-	if !plan.Items.IsNull() && !plan.Items.IsUnknown() {
-		diags.Append(plan.Items.ElementsAs(ctx, &planItems, false)...)
-	}
-	if !state.Items.IsNull() && !state.Items.IsUnknown() {
-		diags.Append(state.Items.ElementsAs(ctx, &stateItems, false)...)
-	}
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	desiredItems, d := tfItemsToConvertItems(ctx, plan.Items)
+	input, d := targetedUpdateInput(ctx, plan, state, currentForm.Items)
 	diags.Append(d...)
 	if diags.HasError() {
-		return nil, diags
+		return nil, nil, diags
 	}
+	contentJSONMode := !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != ""
 
-	// Index current items by itemId to find existing items quickly.
-	type itemRef struct {
-		index int
-		item  *forms.Item
-	}
-	byID := make(map[string]itemRef, len(currentForm.Items))
-	currentOrder := make([]string, 0, len(currentForm.Items))
-	for i, it := range currentForm.Items {
-		if it != nil && it.ItemId != "" {
-			byID[it.ItemId] = itemRef{index: i, item: it}
-			currentOrder = append(currentOrder, it.ItemId)
+	itemPlan, err := convert.PlanTargetedUpdate(input)
+	if err != nil {
+		var planErr *convert.TargetedPlanError
+		if errors.As(err, &planErr) {
+			diags.AddError(planErr.Summary, planErr.Detail)
+		} else {
+			diags.AddError("Targeted Update Failed", err.Error())
 		}
+		return nil, nil, diags
+	}
+
+	diags.Append(r.guardItemResponseLoss(ctx, plan, state.ID.ValueString(), currentForm.Items, removedItemIDs(input, itemPlan), keyMap)...)
+	if diags.HasError() {
+		return nil, nil, diags
 	}
 
 	var requests []*forms.Request
@@ -480,284 +572,109 @@ func (r *FormResource) updateTargeted(
 		}
 	}
 
-	// Build state item_key -> google_item_id map for existing items.
-	stateKeyToID := make(map[string]string, len(stateItems))
-	for _, it := range stateItems {
-		key := it.ItemKey.ValueString()
-		gid := it.GoogleItemID.ValueString()
-		if key != "" && gid != "" {
-			stateKeyToID[key] = gid
-		}
-	}
+	// Deletes, moves and in-place updates of existing items.
+	requests = append(requests, itemPlan.Deletes...)
+	requests = append(requests, itemPlan.Moves...)
+	requests = append(requests, itemPlan.Updates...)
 
-	// Resolve known section references using existing state mappings.
-	// Missing keys are allowed here because the target section may be created later in this apply.
-	if err := convert.ResolveChoiceOptionSectionIDs(desiredItems, stateKeyToID, true); err != nil {
-		diags.AddError("Resolve Choice Navigation Failed", err.Error())
-		return nil, diags
-	}
-
-	planKeySeen := make(map[string]bool, len(planItems))
-	planExistingIDs := make([]string, 0, len(planItems))
-	for _, it := range planItems {
-		key := it.ItemKey.ValueString()
-		planKeySeen[key] = true
-		if gid, ok := stateKeyToID[key]; ok {
-			if _, exists := byID[gid]; exists {
-				planExistingIDs = append(planExistingIDs, gid)
-				continue
-			}
-			if manageMode == "partial" {
-				// Previously-managed item was deleted out-of-band; treat as new.
-				delete(stateKeyToID, key)
-				continue
-			}
-
-			diags.AddError(
-				"Targeted Update Failed",
-				fmt.Sprintf("State tracked item %q (google_item_id=%s) not found in current form. Switch to update_strategy = \"replace_all\".", key, gid),
-			)
-			return nil, diags
-		}
-	}
-
-	// Validate that all items in the current form are tracked by state (required for safe moves/deletes).
-	if manageMode == "all" {
-		for _, id := range currentOrder {
-			if _, ok := keyMap[id]; !ok {
-				diags.AddError(
-					"Targeted Update Requires Full Item State",
-					"One or more existing items are not present in Terraform state (missing google_item_id mapping). Import the form or switch to update_strategy = \"replace_all\".",
-				)
-				return nil, diags
-			}
+	// Items whose type changed are deleted and re-created in place.
+	createReqIndexToKey := make(map[int]string, len(itemPlan.ReplaceKeys)+len(itemPlan.Creates))
+	replaced := 0
+	for _, req := range itemPlan.Replacements {
+		if req.CreateItem != nil {
+			createReqIndexToKey[len(requests)] = itemPlan.ReplaceKeys[replaced]
+			replaced++
 		}
+		requests = append(requests, req)
 	}
-
-	// Step A: delete items that exist in state but are removed from plan.
-	deleteIndices := make([]int, 0)
-	for _, st := range stateItems {
-		key := st.ItemKey.ValueString()
-		if key == "" {
-			continue
+	if len(itemPlan.ReplaceKeys) > 0 {
+		stale := make(map[string]bool, len(itemPlan.ReplaceKeys))
+		for _, key := range itemPlan.ReplaceKeys {
+			stale[key] = true
 		}
-		if !planKeySeen[key] {
-			gid := st.GoogleItemID.ValueString()
-			ref, ok := byID[gid]
-			if !ok {
-				continue
+		for gid, key := range keyMap {
+			if stale[key] {
+				delete(keyMap, gid)
 			}
-			deleteIndices = append(deleteIndices, ref.index)
 		}
 	}
-	// delete in reverse index order
-	for i := 0; i < len(deleteIndices); i++ {
-		for j := i + 1; j < len(deleteIndices); j++ {
-			if deleteIndices[i] < deleteIndices[j] {
-				deleteIndices[i], deleteIndices[j] = deleteIndices[j], deleteIndices[i]
-			}
-		}
+
+	if !planQuiz && stateQuiz {
+		// Disabling quiz: must clear grading first, then disable quiz.
+		requests = append(requests, convert.BuildQuizSettingsRequest(false))
 	}
-	for _, idx := range deleteIndices {
-		requests = append(requests, &forms.Request{DeleteItem: &forms.DeleteItemRequest{Location: &forms.Location{Index: int64(idx)}}})
-		currentOrder = append(currentOrder[:idx], currentOrder[idx+1:]...)
+
+	// New items at their intended indices (in plan order).
+	for i, req := range itemPlan.Creates {
+		createReqIndexToKey[len(requests)] = itemPlan.CreateKeys[i]
+		requests = append(requests, req)
 	}
 
-	// Step B: reorder existing items (ignoring new items) to match plan order.
-	if manageMode == "all" {
-		if len(currentOrder) != len(planExistingIDs) {
-			diags.AddError(
-				"Targeted Update Structural Mismatch",
-				"After applying deletions, the remaining item count does not match the number of existing items in the plan. Switch to update_strategy = \"replace_all\".",
-			)
-			return nil, diags
-		}
+	keyToID := itemPlan.KeyToID
+	if len(requests) > 0 {
+		tflog.Debug(ctx, "executing batchUpdate (targeted)", map[string]interface{}{
+			"request_count": len(requests),
+		})
 
-		for targetIdx, wantID := range planExistingIDs {
-			curIdx := indexOf(currentOrder, wantID)
-			if curIdx < 0 {
-				diags.AddError(
-					"Targeted Update Failed To Locate Existing Item",
-					fmt.Sprintf("Could not find existing item ID %s in the current form. Switch to update_strategy = \"replace_all\".", wantID),
-				)
-				return nil, diags
-			}
-			if curIdx != targetIdx {
-				requests = append(requests, &forms.Request{
-					MoveItem: &forms.MoveItemRequest{
-						OriginalLocation: &forms.Location{Index: int64(curIdx)},
-						NewLocation:      &forms.Location{Index: int64(targetIdx)},
-					},
-				})
-				// simulate move
-				id := currentOrder[curIdx]
-				currentOrder = append(currentOrder[:curIdx], currentOrder[curIdx+1:]...)
-				before := currentOrder[:targetIdx]
-				after := currentOrder[targetIdx:]
-				currentOrder = append(append(append([]string{}, before...), id), after...)
-			}
-		}
-	} else {
-		// partial: permute managed items within their existing slots so that
-		// unmanaged items are left in their original order/positions.
-		managedSet := make(map[string]bool, len(planExistingIDs))
-		for _, gid := range planExistingIDs {
-			managedSet[gid] = true
+		var writeControl *forms.WriteControl
+		if conflictPolicy == "fail" && !state.RevisionID.IsNull() && !state.RevisionID.IsUnknown() && state.RevisionID.ValueString() != "" {
+			writeControl = &forms.WriteControl{RequiredRevisionId: state.RevisionID.ValueString()}
 		}
 
-		managedSlots := make([]int, 0, len(planExistingIDs))
-		for idx, id := range currentOrder {
-			if managedSet[id] {
-				managedSlots = append(managedSlots, idx)
+		// recordCreated adds the items created by the sent requests to keyMap
+		// and keyToID.
+		recordCreated := func(done *forms.BatchUpdateFormResponse, sent []*forms.Request) error {
+			createdKeyMap, err := extractCreateItemKeyMap(done, sent, createReqIndexToKey)
+			if err != nil {
+				return err
 			}
+			for gid, k := range createdKeyMap {
+				keyMap[gid] = k
+				keyToID[k] = gid
+			}
+			return nil
 		}
-		if len(managedSlots) != len(planExistingIDs) {
-			diags.AddError(
-				"Targeted Update Failed",
-				"Could not compute managed item slots for partial management mode. Switch to update_strategy = \"replace_all\" or set manage_mode = \"all\".",
-			)
-			return nil, diags
-		}
-
-		desiredOrder := append([]string{}, currentOrder...)
-		for i, slot := range managedSlots {
-			desiredOrder[slot] = planExistingIDs[i]
-		}
-
-		for targetIdx, wantID := range desiredOrder {
-			curIdx := indexOf(currentOrder, wantID)
-			if curIdx < 0 {
-				diags.AddError(
-					"Targeted Update Failed To Locate Existing Item",
-					fmt.Sprintf("Could not find existing item ID %s in the current form. Switch to update_strategy = \"replace_all\".", wantID),
-				)
-				return nil, diags
+		onChunk := func(done *forms.BatchUpdateFormResponse, sent int) {
+			if saveProgress == nil || recordCreated(done, requests[:sent]) != nil {
+				return
 			}
-			if curIdx != targetIdx {
-				requests = append(requests, &forms.Request{
-					MoveItem: &forms.MoveItemRequest{
-						OriginalLocation: &forms.Location{Index: int64(curIdx)},
-						NewLocation:      &forms.Location{Index: int64(targetIdx)},
-					},
-				})
-				// simulate move
-				id := currentOrder[curIdx]
-				currentOrder = append(currentOrder[:curIdx], currentOrder[curIdx+1:]...)
-				before := currentOrder[:targetIdx]
-				after := currentOrder[targetIdx:]
-				currentOrder = append(append(append([]string{}, before...), id), after...)
+			if progress, d := targetedProgressState(ctx, state, plan, input.Desired, keyToID); !d.HasError() {
+				saveProgress(progress)
 			}
 		}
-	}
-
-	// Step C: update existing items in-place.
-	for i, pi := range planItems {
-		key := pi.ItemKey.ValueString()
-		gid, ok := stateKeyToID[key]
-		if !ok {
-			continue
-		}
-		ref, ok := byID[gid]
-		if !ok || ref.item == nil {
-			diags.AddError("Targeted Update Failed", fmt.Sprintf("Could not find API item %s for %q", gid, key))
-			return nil, diags
-		}
-		idx := indexOf(currentOrder, gid)
-		if idx < 0 {
-			diags.AddError("Targeted Update Failed", fmt.Sprintf("Could not find item %s in current order for %q", gid, key))
-			return nil, diags
-		}
-
-		updated, changed, needsReplace, err := convert.ApplyDesiredItem(ref.item, desiredItems[i])
+		apiResp, sent, err := r.batchUpdateChunked(ctx, state.ID.ValueString(), requests, batchChunkSize(plan), writeControl, onChunk)
 		if err != nil {
-			diags.AddError("Targeted Update Failed", err.Error())
-			return nil, diags
-		}
-		if needsReplace {
-			diags.AddError(
-				"Targeted Update Requires Replace-All",
-				fmt.Sprintf("Item %q requires a structural change (e.g. question type change) and cannot be updated in-place. Switch to update_strategy = \"replace_all\".", key),
-			)
-			return nil, diags
+			detail := fmt.Sprintf("BatchUpdate failed for form %s: %s", state.ID.ValueString(), err)
+			if sent > 0 {
+				detail += " Items created so far were saved to state; apply again to apply the remaining changes."
+			}
+			diags.AddError("Error Updating Google Form", detail)
+			return nil, nil, diags
 		}
-
-		if changed {
-			requests = append(requests, &forms.Request{
-				UpdateItem: &forms.UpdateItemRequest{
-					Item:       updated,
-					Location:   &forms.Location{Index: int64(idx)},
-					UpdateMask: "*",
-				},
-			})
+		if err := recordCreated(apiResp, requests); err != nil {
+			diags.AddWarning("Item Key Correlation Failed", err.Error())
 		}
 	}
 
-	if !planQuiz && stateQuiz {
-		// Disabling quiz: must clear grading first, then disable quiz.
-		requests = append(requests, convert.BuildQuizSettingsRequest(false))
-	}
-
-	// Step D: create new items at their intended indices (in plan order).
-	createReqIndexToKey := make(map[int]string)
-	for i := range planItems {
-		key := planItems[i].ItemKey.ValueString()
-		if _, ok := stateKeyToID[key]; ok {
-			continue
-		}
-		// New item: in partial mode, append by default to avoid shifting unmanaged items.
-		insertIdx := i
-		if manageMode == "partial" && partialNewItemPolicy == "append" {
-			insertIdx = len(currentOrder)
-		}
-		req, err := convert.ItemModelToCreateRequest(desiredItems[i], insertIdx)
-		if err != nil {
-			diags.AddError("Error Building Item Requests", err.Error())
-			return nil, diags
+	if contentJSONMode {
+		ids := make([]string, len(input.Desired))
+		for i, it := range input.Desired {
+			ids[i] = keyToID[it.ItemKey]
 		}
-		createReqIndexToKey[len(requests)] = key
-		requests = append(requests, req)
-		// simulate insert so subsequent create indices line up
-		currentOrder = append(currentOrder[:insertIdx], append([]string{"__new__"}, currentOrder[insertIdx:]...)...)
+		return keyMap, ids, diags
 	}
 
 	if len(requests) == 0 {
-		return keyMap, diags
-	}
-
-	tflog.Debug(ctx, "executing batchUpdate (targeted)", map[string]interface{}{
-		"request_count": len(requests),
-	})
-
-	batchReq := &forms.BatchUpdateFormRequest{
-		Requests:              requests,
-		IncludeFormInResponse: true,
-	}
-	if conflictPolicy == "fail" && !state.RevisionID.IsNull() && !state.RevisionID.IsUnknown() && state.RevisionID.ValueString() != "" {
-		batchReq.WriteControl = &forms.WriteControl{RequiredRevisionId: state.RevisionID.ValueString()}
-	}
-
-	apiResp, err := r.client.Forms.BatchUpdate(ctx, state.ID.ValueString(), batchReq)
-	if err != nil {
-		diags.AddError("Error Updating Google Form", fmt.Sprintf("BatchUpdate failed for form %s: %s", state.ID.ValueString(), err))
-		return nil, diags
-	}
-
-	createdKeyMap, derr := extractCreateItemKeyMap(apiResp, requests, createReqIndexToKey)
-	if derr != nil {
-		diags.AddWarning("Item Key Correlation Failed", derr.Error())
-	} else {
-		for gid, k := range createdKeyMap {
-			keyMap[gid] = k
-		}
+		return keyMap, nil, diags
 	}
 
 	// Apply go_to_section_* navigation updates after any creates so section IDs are resolvable.
-	if navDiags := r.applyChoiceNavigationUpdates(ctx, state.ID.ValueString(), desiredItems, keyMap); navDiags.HasError() {
+	if navDiags := r.applyChoiceNavigationUpdates(ctx, state.ID.ValueString(), input.Desired, keyMap); navDiags.HasError() {
 		diags.Append(navDiags...)
-		return nil, diags
+		return nil, nil, diags
 	}
 
-	return keyMap, diags
+	return keyMap, nil, diags
 }
 
 func extractCreateItemKeyMap(
@@ -796,6 +713,29 @@ func extractCreateItemKeyMap(
 	return out, nil
 }
 
+// createdItemIDs returns the item IDs of all CreateItem requests, in request
+// order.
+func createdItemIDs(resp *forms.BatchUpdateFormResponse, requests []*forms.Request) ([]string, error) {
+	var ids []string
+	if resp == nil {
+		return nil, fmt.Errorf("nil BatchUpdateFormResponse")
+	}
+	if len(resp.Replies) != len(requests) {
+		return nil, fmt.Errorf("reply count mismatch: got %d replies for %d requests", len(resp.Replies), len(requests))
+	}
+	for i := range requests {
+		if requests[i] == nil || requests[i].CreateItem == nil {
+			continue
+		}
+		rep := resp.Replies[i]
+		if rep == nil || rep.CreateItem == nil || strings.TrimSpace(rep.CreateItem.ItemId) == "" {
+			return nil, fmt.Errorf("missing createItem reply for request[%d]", i)
+		}
+		ids = append(ids, rep.CreateItem.ItemId)
+	}
+	return ids, nil
+}
+
 func buildCreateReqIndexToKey(requests []*forms.Request, createKeys []string) (map[int]string, error) {
 	out := make(map[int]string)
 	next := 0
@@ -815,11 +755,126 @@ func buildCreateReqIndexToKey(requests []*forms.Request, createKeys []string) (m
 	return out, nil
 }
 
-func indexOf(s []string, want string) int {
-	for i := range s {
-		if s[i] == want {
-			return i
+// itemUpdateStrategy returns the update_strategy an update of plan applies.
+// An update resuming an incomplete apply is targeted, so that it keeps the
+// items created so far.
+func itemUpdateStrategy(plan FormResourceModel, resuming bool) string {
+	if resuming {
+		return "targeted"
+	}
+	if !plan.UpdateStrategy.IsNull() && !plan.UpdateStrategy.IsUnknown() && plan.UpdateStrategy.ValueString() != "" {
+		return plan.UpdateStrategy.ValueString()
+	}
+	return "replace_all"
+}
+
+// targetedUpdateInput returns the input of the targeted planner for an update
+// of state to plan, given the current items of the form. The plan preview
+// uses it with the items in state.
+func targetedUpdateInput(
+	ctx context.Context,
+	plan FormResourceModel,
+	state FormResourceModel,
+	current []*forms.Item,
+) (convert.TargetedInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	manageMode := "all"
+	if !plan.ManageMode.IsNull() && !plan.ManageMode.IsUnknown() && plan.ManageMode.ValueString() != "" {
+		manageMode = plan.ManageMode.ValueString()
+	}
+
+	partialNewItemPolicy := "append"
+	if !plan.PartialNewItemPolicy.IsNull() && !plan.PartialNewItemPolicy.IsUnknown() && plan.PartialNewItemPolicy.ValueString() != "" {
+		partialNewItemPolicy = plan.PartialNewItemPolicy.ValueString()
+	}
+
+	// unmanaged_item_policy = "keep" leaves untracked items in place like
+	// partial mode, but new items still go to their planned index.
+	keepUnmanaged := manageMode == "all" && unmanagedItemPolicy(plan) == "keep"
+	input := convert.TargetedInput{
+		Current:              current,
+		Partial:              manageMode == "partial" || keepUnmanaged,
+		AppendNew:            manageMode == "partial" && partialNewItemPolicy == "append",
+		AllowTypeReplacement: !plan.AllowTypeReplacement.IsNull() && !plan.AllowTypeReplacement.IsUnknown() && plan.AllowTypeReplacement.ValueBool(),
+	}
+
+	if !plan.ContentJSON.IsNull() && !plan.ContentJSON.IsUnknown() && plan.ContentJSON.ValueString() != "" {
+		// content_json items have no item_keys; correlate them with existing
+		// items by explicit itemId, content, or the IDs stored by the last apply.
+		storedIDs, d := contentJSONItemIDsFromTF(ctx, state.ContentJSONItemIDs)
+		diags.Append(d...)
+		if diags.HasError() {
+			return input, diags
 		}
+		corr, err := convert.CorrelateContentJSON(plan.ContentJSON.ValueString(), current, storedIDs, input.Partial)
+		if err != nil {
+			diags.AddError("Error Parsing content_json", fmt.Sprintf("Could not parse content_json: %s", err))
+			return input, diags
+		}
+		input.Desired = corr.Items
+		input.KeyToID = corr.KeyToID
+		input.Managed = corr.Managed
+		return input, diags
+	}
+
+	desired, keyToID, managed, d := targetedItemInput(ctx, plan, state)
+	diags.Append(d...)
+	if diags.HasError() {
+		return input, diags
+	}
+	input.Desired = desired
+	input.KeyToID = keyToID
+	input.Managed = managed
+	// Items kept out of state by an earlier policy are deleted once the
+	// policy becomes "delete".
+	if manageMode == "all" && unmanagedItemPolicy(plan) == "delete" && keepsUnmanagedItems(state) {
+		for _, it := range current {
+			if it != nil && it.ItemId != "" {
+				input.Managed[it.ItemId] = true
+			}
+		}
+	}
+	return input, diags
+}
+
+// targetedItemInput returns the desired items of a targeted update of item
+// blocks, together with the item_key -> google_item_id mapping and the set of
+// managed item IDs recorded in state.
+func targetedItemInput(
+	ctx context.Context,
+	plan FormResourceModel,
+	state FormResourceModel,
+) ([]convert.ItemModel, map[string]string, map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var stateItems []ItemModel
+	if !state.Items.IsNull() && !state.Items.IsUnknown() {
+		diags.Append(state.Items.ElementsAs(ctx, &stateItems, false)...)
+	}
+	desiredItems, d := tfItemsToConvertItems(ctx, plan.Items)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, nil, diags
+	}
+
+	// Build state item_key -> google_item_id map for existing items.
+	stateKeyToID := make(map[string]string, len(stateItems))
+	managed := make(map[string]bool, len(stateItems))
+	for _, it := range stateItems {
+		key := it.ItemKey.ValueString()
+		gid := it.GoogleItemID.ValueString()
+		if key != "" && gid != "" {
+			stateKeyToID[key] = gid
+			managed[gid] = true
+		}
+	}
+
+	// Resolve known section references using existing state mappings.
+	// Missing keys are allowed here because the target section may be created later in this apply.
+	if err := convert.ResolveChoiceOptionSectionIDs(desiredItems, stateKeyToID, true); err != nil {
+		diags.AddError("Resolve Choice Navigation Failed", err.Error())
+		return nil, nil, nil, diags
 	}
-	return -1
+	return desiredItems, stateKeyToID, managed, diags
 }
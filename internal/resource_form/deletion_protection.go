@@ -0,0 +1,72 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ModifyPlan fails destroy and replacement plans of protected forms, then
+// previews the item operations of the apply.
+func (r *FormResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if !req.State.Raw.IsNull() {
+		var state FormResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.DeletionProtection.ValueBool() && !createIncomplete(ctx, req.Private) {
+			if req.Plan.Raw.IsNull() {
+				resp.Diagnostics.AddError("Deletion Protection Enabled", deletionProtectedDetail(state))
+				return
+			}
+			var plan FormResourceModel
+			resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			// source_form_id is the only attribute that forces replacement.
+			if !plan.SourceFormID.Equal(state.SourceFormID) {
+				resp.Diagnostics.AddError(
+					"Deletion Protection Enabled",
+					fmt.Sprintf("Changing source_form_id replaces form %s, which has deletion_protection = true. Set deletion_protection = false and apply before replacing the form.", state.ID.ValueString()),
+				)
+				return
+			}
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(r.checkResponsesScope(ctx, req.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(planResume(ctx, req.Private, req.State, &resp.Plan)...)
+	modifyPlanItemOperations(ctx, req, resp)
+}
+
+// createIncomplete reports whether the create of the form failed part-way.
+// Such a form is tainted and only holds what the failed create applied, so
+// deletion_protection does not keep Terraform from replacing it.
+func createIncomplete(ctx context.Context, private privateStateGetter) bool {
+	if private == nil {
+		return false
+	}
+	v, _ := private.GetKey(ctx, privateKeyIncompleteCreate)
+	return len(v) > 0
+}
+
+// deletionProtectedDetail explains why a protected form cannot be destroyed.
+func deletionProtectedDetail(state FormResourceModel) string {
+	return fmt.Sprintf("Form %s has deletion_protection = true. Set deletion_protection = false and apply before destroying the form.", state.ID.ValueString())
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func protectedFormState(t *testing.T, protected bool, sourceFormID string) map[string]tftypes.Value {
+	t.Helper()
+	vals := map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "protected-form"),
+		"title":               tftypes.NewValue(tftypes.String, "Live"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, protected),
+	}
+	if sourceFormID != "" {
+		vals["source_form_id"] = tftypes.NewValue(tftypes.String, sourceFormID)
+	}
+	return vals
+}
+
+func TestModifyPlan_DeletionProtection_BlocksDestroy(t *testing.T) {
+	t.Parallel()
+
+	r := testResource(&testutil.MockFormsAPI{}, &testutil.MockDriveAPI{})
+	state := buildState(t, protectedFormState(t, true, ""))
+	nullPlan := tfsdk.Plan{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}
+
+	resp := &resource.ModifyPlanResponse{Plan: nullPlan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: nullPlan, State: state}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Deletion Protection Enabled" {
+		t.Fatalf("expected a deletion protection error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestModifyPlan_DeletionProtection_BlocksReplacement(t *testing.T) {
+	t.Parallel()
+
+	r := testResource(&testutil.MockFormsAPI{}, &testutil.MockDriveAPI{})
+	state := buildState(t, protectedFormState(t, true, "template-a"))
+	plan := buildPlan(t, protectedFormState(t, true, "template-b"))
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a deletion protection error for a replacement")
+	}
+}
+
+func TestModifyPlan_DeletionProtectionOff_AllowsDestroy(t *testing.T) {
+	t.Parallel()
+
+	r := testResource(&testutil.MockFormsAPI{}, &testutil.MockDriveAPI{})
+	state := buildState(t, protectedFormState(t, false, ""))
+	nullPlan := tfsdk.Plan{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}
+
+	resp := &resource.ModifyPlanResponse{Plan: nullPlan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: nullPlan, State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestDelete_DeletionProtection_RefusesDelete(t *testing.T) {
+	t.Parallel()
+
+	mockDrive := &testutil.MockDriveAPI{
+		TrashFunc: func(_ context.Context, _ string, _ bool) error {
+			t.Error("Drive.Trash should not be called for a protected form")
+			return nil
+		},
+	}
+	r := testResource(&testutil.MockFormsAPI{}, mockDrive)
+	state := buildState(t, protectedFormState(t, true, ""))
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error deleting a protected form")
+	}
+}
+
+// fakePrivate is private state holding the given keys.
+type fakePrivate map[string][]byte
+
+func (p fakePrivate) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func TestCreateIncomplete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	if createIncomplete(ctx, nil) {
+		t.Error("createIncomplete(nil) = true, want false")
+	}
+	if createIncomplete(ctx, fakePrivate{privateKeyIncompleteApply: []byte("true")}) {
+		t.Error("an incomplete update must not lift deletion protection")
+	}
+	if !createIncomplete(ctx, fakePrivate{privateKeyIncompleteCreate: []byte("true")}) {
+		t.Error("createIncomplete() = false for a form whose create failed, want true")
+	}
+}
@@ -5,20 +5,201 @@ package resourceform
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	forms "google.golang.org/api/forms/v1"
 )
 
+// privateKeyImportItemKeys holds, in private state, the item_key options given
+// in the import ID until the Read that follows the import applies them.
+const privateKeyImportItemKeys = "import_item_keys"
+
+// privateKeyImported marks, in private state, a form that was just imported,
+// so that the Read that follows adopts all of its items.
+const privateKeyImported = "imported"
+
+// importItemKeys are the item_key options of an import ID.
+type importItemKeys struct {
+	// Slug derives keys for unmapped items from their titles.
+	Slug bool `json:"slug,omitempty"`
+	// Keys maps Google item IDs to item_keys.
+	Keys map[string]string `json:"keys,omitempty"`
+}
+
 // ImportState handles terraform import for existing Google Forms.
-// Usage: terraform import googleforms_form.example FORM_ID
+// Usage:
+//
+//	terraform import googleforms_form.example FORM_ID
+//	terraform import googleforms_form.example 'FORM_ID?keys=slug'
+//	terraform import googleforms_form.example 'FORM_ID#intro=1a2b,email=3c4d'
 //
-// After import, items receive auto-generated item_keys (item_0, item_1, ...).
-// Users should review and rename these in their configuration.
+// After import, items get the item_keys this provider saved in the form's
+// Drive appProperties at the last apply. Keys given after "#" (item_key=ITEM_ID)
+// take precedence. Other items receive auto-generated item_keys: slugs of
+// their titles with "?keys=slug", otherwise positional keys (item_0, item_1,
+// ...); users should review and rename these in their configuration.
 func (r *FormResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	formID, opts, err := parseFormImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Could not parse import ID %q: %s. Expected FORM_ID, FORM_ID?keys=slug or FORM_ID#item_key=ITEM_ID,...", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), formID)...)
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImported, []byte("true"))...)
+	}
+	if resp.Diagnostics.HasError() || (!opts.Slug && len(opts.Keys) == 0) {
+		return
+	}
+
+	data, err := json.Marshal(opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImportItemKeys, data)...)
+	}
+}
+
+// parseFormImportID splits an import ID of the form
+// FORM_ID[?keys=slug][#item_key=ITEM_ID,...] into the form ID and its
+// item_key options.
+func parseFormImportID(id string) (string, importItemKeys, error) {
+	var opts importItemKeys
+
+	rest, mapping, hasMapping := strings.Cut(strings.TrimSpace(id), "#")
+	formID, query, hasQuery := strings.Cut(rest, "?")
+	if formID == "" {
+		return "", opts, fmt.Errorf("form ID is empty")
+	}
+
+	if hasQuery {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return "", opts, fmt.Errorf("invalid query: %w", err)
+		}
+		for name, vals := range values {
+			if name != "keys" {
+				return "", opts, fmt.Errorf("unknown option %q", name)
+			}
+			if len(vals) != 1 || vals[0] != "slug" {
+				return "", opts, fmt.Errorf("keys must be \"slug\"")
+			}
+			opts.Slug = true
+		}
+	}
+
+	if hasMapping {
+		opts.Keys = map[string]string{}
+		usedKeys := map[string]bool{}
+		for _, pair := range strings.Split(mapping, ",") {
+			key, itemID, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			key, itemID = strings.TrimSpace(key), strings.TrimSpace(itemID)
+			if !ok || key == "" || itemID == "" {
+				return "", opts, fmt.Errorf("mapping %q must be item_key=ITEM_ID", pair)
+			}
+			if usedKeys[key] {
+				return "", opts, fmt.Errorf("item_key %q is mapped more than once", key)
+			}
+			if _, dup := opts.Keys[itemID]; dup {
+				return "", opts, fmt.Errorf("item ID %q is mapped more than once", itemID)
+			}
+			usedKeys[key] = true
+			opts.Keys[itemID] = key
+		}
+	}
+
+	return formID, opts, nil
+}
+
+// importItemKeysFromPrivate returns the item_key options saved by ImportState,
+// or nil when the resource was not just imported with any.
+func importItemKeysFromPrivate(ctx context.Context, private privateStateGetter) (*importItemKeys, diag.Diagnostics) {
+	if private == nil {
+		return nil, nil
+	}
+	data, diags := private.GetKey(ctx, privateKeyImportItemKeys)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+	var opts importItemKeys
+	if err := json.Unmarshal(data, &opts); err != nil {
+		diags.AddError("Invalid Import State", fmt.Sprintf("Could not decode import item_key options: %s", err))
+		return nil, diags
+	}
+	return &opts, diags
+}
+
+// justImported reports whether the form was imported since the last Read.
+func justImported(ctx context.Context, private privateStateGetter) bool {
+	if private == nil {
+		return false
+	}
+	v, _ := private.GetKey(ctx, privateKeyImported)
+	return len(v) > 0
+}
+
+// privateStateGetter is the read side of private state.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// mergeImportItemKeys overlays the explicit import mapping (item ID ->
+// item_key) on keyMap. Explicit keys win over recovered ones, and mapped item
+// IDs that are not in the form are reported as warnings.
+func mergeImportItemKeys(keyMap, explicit map[string]string, form *forms.Form) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(explicit) == 0 {
+		return keyMap, diags
+	}
+
+	inForm := map[string]bool{}
+	for _, item := range form.Items {
+		if item != nil {
+			inForm[item.ItemId] = true
+		}
+	}
+	explicitKeys := map[string]bool{}
+	for _, key := range explicit {
+		explicitKeys[key] = true
+	}
+
+	merged := map[string]string{}
+	for id, key := range keyMap {
+		if _, ok := explicit[id]; !ok && !explicitKeys[key] {
+			merged[id] = key
+		}
+	}
+	ids := make([]string, 0, len(explicit))
+	for id := range explicit {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if !inForm[id] {
+			diags.AddWarning(
+				"Import Item Not Found",
+				fmt.Sprintf("The import ID maps item_key %q to item %q, which is not in the form.", explicit[id], id),
+			)
+			continue
+		}
+		merged[id] = explicit[id]
+	}
+	return merged, diags
 }
@@ -0,0 +1,128 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	forms "google.golang.org/api/forms/v1"
+)
+
+func TestParseFormImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		wantID   string
+		wantOpts importItemKeys
+		wantErr  bool
+	}{
+		{name: "plain", id: "abc", wantID: "abc"},
+		{name: "slug", id: "abc?keys=slug", wantID: "abc", wantOpts: importItemKeys{Slug: true}},
+		{
+			name:     "mapping",
+			id:       "abc#intro=1a2b, email=3c4d",
+			wantID:   "abc",
+			wantOpts: importItemKeys{Keys: map[string]string{"1a2b": "intro", "3c4d": "email"}},
+		},
+		{
+			name:     "slug and mapping",
+			id:       "abc?keys=slug#intro=1a2b",
+			wantID:   "abc",
+			wantOpts: importItemKeys{Slug: true, Keys: map[string]string{"1a2b": "intro"}},
+		},
+		{name: "empty form ID", id: "?keys=slug", wantErr: true},
+		{name: "unknown option", id: "abc?foo=bar", wantErr: true},
+		{name: "unknown key strategy", id: "abc?keys=index", wantErr: true},
+		{name: "missing item ID", id: "abc#intro=", wantErr: true},
+		{name: "duplicate item_key", id: "abc#intro=1,intro=2", wantErr: true},
+		{name: "duplicate item ID", id: "abc#intro=1,email=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotID, gotOpts, err := parseFormImportID(tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %q", tt.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotID != tt.wantID {
+				t.Errorf("form ID = %q, want %q", gotID, tt.wantID)
+			}
+			if !reflect.DeepEqual(gotOpts, tt.wantOpts) {
+				t.Errorf("options = %+v, want %+v", gotOpts, tt.wantOpts)
+			}
+		})
+	}
+}
+
+func TestImportState_SetsFormID(t *testing.T) {
+	t.Parallel()
+
+	r := testResource(nil, nil)
+	resp := &resource.ImportStateResponse{State: emptyState(t)}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "form-1#intro=1a2b"}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if got := stateFormID(t, resp.State); got != "form-1" {
+		t.Fatalf("id = %q, want form-1", got)
+	}
+}
+
+// initPrivate gives a response the private state that Terraform provides,
+// which tests cannot construct directly. field points to its Private field.
+func initPrivate(field any) {
+	private := reflect.ValueOf(field).Elem()
+	private.Set(reflect.New(private.Type().Elem()))
+}
+
+// importForm runs ImportState for id with private state.
+func importForm(t *testing.T, r *FormResource, id string) *resource.ImportStateResponse {
+	t.Helper()
+	resp := &resource.ImportStateResponse{State: emptyState(t)}
+	initPrivate(&resp.Private)
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import errors: %v", resp.Diagnostics.Errors())
+	}
+	return resp
+}
+
+func TestImportState_MarksImport(t *testing.T) {
+	t.Parallel()
+
+	resp := importForm(t, testResource(nil, nil), "form-1")
+	if !justImported(context.Background(), resp.Private) {
+		t.Error("ImportState did not mark the form as just imported")
+	}
+}
+
+func TestMergeImportItemKeys(t *testing.T) {
+	t.Parallel()
+
+	form := &forms.Form{Items: []*forms.Item{{ItemId: "a"}, {ItemId: "b"}, {ItemId: "c"}}}
+	recovered := map[string]string{"a": "intro", "b": "old_b", "c": "email"}
+	explicit := map[string]string{"b": "intro", "zzz": "ghost"}
+
+	got, diags := mergeImportItemKeys(recovered, explicit, form)
+	want := map[string]string{"b": "intro", "c": "email"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("merged = %v, want %v", got, want)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning for the unknown item ID, got %d", diags.WarningsCount())
+	}
+}
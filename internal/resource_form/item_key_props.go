@@ -0,0 +1,83 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// saveItemKeyProperties writes the item_key mapping of items to the form's
+// Drive appProperties so that an import can recover the original item_keys.
+// It is best-effort: failures are reported as warnings.
+func (r *FormResource) saveItemKeyProperties(
+	ctx context.Context,
+	formID string,
+	items types.List,
+	supportsAllDrives bool,
+) diag.Diagnostics {
+	keyMap, diags := buildItemKeyMap(ctx, items)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired, err := convert.EncodeItemKeyProperties(keyMap)
+	if err != nil {
+		diags.AddWarning("Item Key Mapping Not Saved", err.Error())
+		return diags
+	}
+
+	existing, err := r.client.Drive.GetAppProperties(ctx, formID, supportsAllDrives)
+	if err != nil {
+		diags.AddWarning("Item Key Mapping Not Saved", err.Error())
+		return diags
+	}
+
+	stale := convert.StaleItemKeyProperties(existing, desired)
+	if len(stale) == 0 && containsProperties(existing, desired) {
+		return diags
+	}
+
+	tflog.Debug(ctx, "saving item_key mapping to Drive appProperties", map[string]interface{}{
+		"form_id":    formID,
+		"item_count": len(keyMap),
+	})
+	if err := r.client.Drive.UpdateAppProperties(ctx, formID, desired, stale, supportsAllDrives); err != nil {
+		diags.AddWarning("Item Key Mapping Not Saved", err.Error())
+	}
+	return diags
+}
+
+// loadItemKeyProperties reads the item_key mapping saved by
+// saveItemKeyProperties. It returns nil when none is available.
+func (r *FormResource) loadItemKeyProperties(
+	ctx context.Context,
+	formID string,
+	supportsAllDrives bool,
+) map[string]string {
+	props, err := r.client.Drive.GetAppProperties(ctx, formID, supportsAllDrives)
+	if err != nil {
+		tflog.Warn(ctx, "could not read item_key mapping from Drive appProperties", map[string]interface{}{
+			"form_id": formID,
+			"error":   err.Error(),
+		})
+		return nil
+	}
+	return convert.DecodeItemKeyProperties(props)
+}
+
+// containsProperties reports whether props already holds every entry of want.
+func containsProperties(props, want map[string]string) bool {
+	for k, v := range want {
+		if cur, ok := props[k]; !ok || cur != v {
+			return false
+		}
+	}
+	return true
+}
@@ -8,27 +8,59 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // FormResourceModel describes the Terraform state for googleforms_form.
 type FormResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	Title                types.String `tfsdk:"title"`
-	Description          types.String `tfsdk:"description"`
-	Published            types.Bool   `tfsdk:"published"`
-	AcceptingResponses   types.Bool   `tfsdk:"accepting_responses"`
-	Quiz                 types.Bool   `tfsdk:"quiz"`
-	EmailCollectionType  types.String `tfsdk:"email_collection_type"`
-	UpdateStrategy       types.String `tfsdk:"update_strategy"`
-	DangerousReplaceAll  types.Bool   `tfsdk:"dangerously_replace_all_items"`
-	ManageMode           types.String `tfsdk:"manage_mode"`
-	PartialNewItemPolicy types.String `tfsdk:"partial_new_item_policy"`
-	ConflictPolicy       types.String `tfsdk:"conflict_policy"`
-	FolderID             types.String `tfsdk:"folder_id"`
-	SupportsAllDrives    types.Bool   `tfsdk:"supports_all_drives"`
-	ParentIDs            types.List   `tfsdk:"parent_ids"`
-	Items                types.List   `tfsdk:"item"`
-	ContentJSON          types.String `tfsdk:"content_json"`
-	ResponderURI         types.String `tfsdk:"responder_uri"`
-	EditURI              types.String `tfsdk:"edit_uri"`
-	DocumentTitle        types.String `tfsdk:"document_title"`
-	RevisionID           types.String `tfsdk:"revision_id"`
+	ID                      types.String           `tfsdk:"id"`
+	Title                   types.String           `tfsdk:"title"`
+	Description             types.String           `tfsdk:"description"`
+	Published               types.Bool             `tfsdk:"published"`
+	AcceptingResponses      types.Bool             `tfsdk:"accepting_responses"`
+	Quiz                    types.Bool             `tfsdk:"quiz"`
+	EmailCollectionType     types.String           `tfsdk:"email_collection_type"`
+	UpdateStrategy          types.String           `tfsdk:"update_strategy"`
+	DangerousReplaceAll     types.Bool             `tfsdk:"dangerously_replace_all_items"`
+	AllowTypeReplacement    types.Bool             `tfsdk:"allow_item_type_replacement"`
+	BatchChunkSize          types.Int64            `tfsdk:"batch_chunk_size"`
+	ManageMode              types.String           `tfsdk:"manage_mode"`
+	PartialNewItemPolicy    types.String           `tfsdk:"partial_new_item_policy"`
+	UnmanagedItemPolicy     types.String           `tfsdk:"unmanaged_item_policy"`
+	ConflictPolicy          types.String           `tfsdk:"conflict_policy"`
+	ResponseProtection      types.String           `tfsdk:"response_protection"`
+	AcknowledgeResponseLoss types.Set              `tfsdk:"acknowledge_response_loss"`
+	DeletionPolicy          types.String           `tfsdk:"deletion_policy"`
+	DeletionProtection      types.Bool             `tfsdk:"deletion_protection"`
+	ArchiveOnDestroy        *ArchiveOnDestroyModel `tfsdk:"archive_on_destroy"`
+	FolderID                types.String           `tfsdk:"folder_id"`
+	SourceFormID            types.String           `tfsdk:"source_form_id"`
+	SupportsAllDrives       types.Bool             `tfsdk:"supports_all_drives"`
+	ParentIDs               types.List             `tfsdk:"parent_ids"`
+	Items                   types.List             `tfsdk:"item"`
+	Sections                types.List             `tfsdk:"section"`
+	ContentJSON             types.String           `tfsdk:"content_json"`
+	ContentJSONItemIDs      types.List             `tfsdk:"content_json_item_ids"`
+	PlannedItemOperations   types.List             `tfsdk:"planned_item_operations"`
+	UnmanagedItems          types.List             `tfsdk:"unmanaged_items"`
+	NavigationGraph         types.String           `tfsdk:"navigation_graph"`
+	ResponderURI            types.String           `tfsdk:"responder_uri"`
+	EditURI                 types.String           `tfsdk:"edit_uri"`
+	DocumentTitle           types.String           `tfsdk:"document_title"`
+	RevisionID              types.String           `tfsdk:"revision_id"`
+	ContentHash             types.String           `tfsdk:"content_hash"`
+}
+
+// ArchiveOnDestroyModel describes the archive_on_destroy block.
+type ArchiveOnDestroyModel struct {
+	FolderID         types.String `tfsdk:"folder_id"`
+	IncludeResponses types.Bool   `tfsdk:"include_responses"`
+}
+
+// SectionModel describes a section block: a page break and the items that
+// follow it.
+type SectionModel struct {
+	SectionKey     types.String `tfsdk:"section_key"`
+	Title          types.String `tfsdk:"title"`
+	Description    types.String `tfsdk:"description"`
+	NextSectionKey types.String `tfsdk:"next_section_key"`
+	GoogleItemID   types.String `tfsdk:"google_item_id"`
+	Items          types.List   `tfsdk:"item"`
 }
 
 // ItemModel describes a single form item in Terraform state.
@@ -51,6 +83,7 @@ type ItemModel struct {
 	Image              *ImageModel              `tfsdk:"image"`
 	Video              *VideoModel              `tfsdk:"video"`
 	SectionHeader      *SectionHeaderModel      `tfsdk:"section_header"`
+	RawJSON            *RawJSONModel            `tfsdk:"raw_json"`
 	GoogleItemID       types.String             `tfsdk:"google_item_id"`
 }
 
@@ -204,10 +237,33 @@ type SectionHeaderModel struct {
 	Description types.String `tfsdk:"description"`
 }
 
+// RawJSONModel carries a single Forms API Item as JSON.
+type RawJSONModel struct {
+	JSON types.String `tfsdk:"json"`
+}
+
 // GradingModel describes quiz grading options for a question.
 type GradingModel struct {
 	Points            types.Int64  `tfsdk:"points"`
 	CorrectAnswer     types.String `tfsdk:"correct_answer"`
+	CorrectAnswers    types.List   `tfsdk:"correct_answers"`
 	FeedbackCorrect   types.String `tfsdk:"feedback_correct"`
 	FeedbackIncorrect types.String `tfsdk:"feedback_incorrect"`
+	GeneralFeedback   types.String `tfsdk:"general_feedback"`
+	FeedbackLink      types.List   `tfsdk:"feedback_link"`
+	FeedbackVideo     types.List   `tfsdk:"feedback_video"`
+}
+
+// FeedbackLinkModel describes a link attached to quiz feedback.
+type FeedbackLinkModel struct {
+	AppliesTo   types.String `tfsdk:"applies_to"`
+	URI         types.String `tfsdk:"uri"`
+	DisplayText types.String `tfsdk:"display_text"`
+}
+
+// FeedbackVideoModel describes a YouTube video attached to quiz feedback.
+type FeedbackVideoModel struct {
+	AppliesTo   types.String `tfsdk:"applies_to"`
+	YoutubeURI  types.String `tfsdk:"youtube_uri"`
+	DisplayText types.String `tfsdk:"display_text"`
 }
@@ -0,0 +1,90 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// navigationGraphValue renders the section navigation of the flat items for
+// the navigation_graph attribute. It is null when there are no items.
+func navigationGraphValue(ctx context.Context, items types.List) (types.String, diag.Diagnostics) {
+	if items.IsNull() || items.IsUnknown() {
+		return types.StringNull(), nil
+	}
+	converted, diags := tfItemsToConvertItems(ctx, items)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+	return types.StringValue(convert.BuildNavigationGraph(converted, nil).DOT()), diags
+}
+
+// validateNavigationGraph warns about sections respondents cannot reach,
+// loops between sections, and branching questions that are not required.
+// A section's next_section_key is where the section continues, not
+// navigation of its question's options, so questions without navigation of
+// their own do not count as branching.
+func validateNavigationGraph(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var items, sections types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("section"), &sections)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	flat, ok, diags := flatSectionItems(ctx, items, sections, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok || len(flat) == 0 {
+		return
+	}
+	var sectionModels []SectionModel
+	if !sections.IsNull() {
+		resp.Diagnostics.Append(sections.ElementsAs(ctx, &sectionModels, false)...)
+	}
+	sectionNext := map[string]string{}
+	for _, s := range sectionModels {
+		if next := s.NextSectionKey; !next.IsNull() && !next.IsUnknown() && next.ValueString() != "" {
+			sectionNext[s.SectionKey.ValueString()] = next.ValueString()
+		}
+	}
+	list, diags := types.ListValueFrom(ctx, itemObjectType(), flat)
+	resp.Diagnostics.Append(diags...)
+	converted, diags := tfItemsToConvertItems(ctx, list)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	g := convert.BuildNavigationGraph(converted, sectionNext)
+
+	if unreachable := g.Unreachable(); len(unreachable) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unreachable Sections",
+			fmt.Sprintf("No path from the start of the form leads to section(s) %s: the section before each does not continue to it and no option goes to it. Respondents never see these sections.", strings.Join(unreachable, ", ")),
+		)
+	}
+	for _, cycle := range g.Cycles() {
+		resp.Diagnostics.AddWarning(
+			"Section Navigation Cycle",
+			fmt.Sprintf("Respondents can go round the sections %s. Make sure an option leads out of the loop; use go_to_action = \"RESTART_FORM\" to start the form over deliberately.", strings.Join(cycle, " -> ")),
+		)
+	}
+	for _, key := range g.OptionalBranches {
+		resp.Diagnostics.AddWarning(
+			"Branching Question Not Required",
+			fmt.Sprintf("Item %q goes to other sections depending on the answer but is not required. Respondents who skip it continue to the next section. Set required = true so every respondent takes one of its branches.", key),
+		)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// Item operations reported in planned_item_operations, in apply order.
+const (
+	itemOpDelete  = "delete"
+	itemOpMove    = "move"
+	itemOpUpdate  = "update"
+	itemOpReplace = "replace"
+	itemOpCreate  = "create"
+)
+
+// PlannedItemOperationModel describes one entry of planned_item_operations.
+type PlannedItemOperationModel struct {
+	Operation    types.String `tfsdk:"operation"`
+	ItemKey      types.String `tfsdk:"item_key"`
+	GoogleItemID types.String `tfsdk:"google_item_id"`
+	Index        types.Int64  `tfsdk:"index"`
+}
+
+// plannedItemOperationAttrTypes returns the attribute types of a
+// planned_item_operations entry.
+func plannedItemOperationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"operation":      types.StringType,
+		"item_key":       types.StringType,
+		"google_item_id": types.StringType,
+		"index":          types.Int64Type,
+	}
+}
+
+func plannedItemOperationObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: plannedItemOperationAttrTypes()}
+}
+
+// noPlannedItemOperations is the value of planned_item_operations outside of
+// a plan.
+func noPlannedItemOperations() types.List {
+	return types.ListValueMust(plannedItemOperationObjectType(), []attr.Value{})
+}
+
+// modifyPlanItemOperations previews the item operations of an apply in
+// planned_item_operations and warns about the deletes and replacements that
+// detach responses.
+// The preview is computed from state with the same planner as targeted
+// updates, so it does not call the API.
+func modifyPlanItemOperations(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var ops types.List
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("planned_item_operations"), &ops)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The framework only marks the attribute unknown when the resource has
+	// changes, and planResume when an incomplete apply is resumed; otherwise
+	// nothing will be applied.
+	if !ops.IsUnknown() {
+		return
+	}
+
+	var plan FormResourceModel
+	resp.Diagnostics.Append(getFormModel(ctx, resp.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *FormResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &FormResourceModel{}
+		resp.Diagnostics.Append(getFormModel(ctx, req.State, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	incomplete, d := req.Private.GetKey(ctx, privateKeyIncompleteApply)
+	resp.Diagnostics.Append(d...)
+	planned, ok := plannedItemOperations(ctx, plan, state, len(incomplete) > 0)
+	if !ok {
+		return
+	}
+
+	var deleted, replaced []string
+	for _, op := range planned {
+		switch op.Operation.ValueString() {
+		case itemOpDelete:
+			deleted = append(deleted, describeItem(op))
+		case itemOpReplace:
+			replaced = append(replaced, describeItem(op))
+		}
+	}
+	if len(deleted) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Items Will Be Deleted",
+			fmt.Sprintf("This apply deletes %s. Responses already collected for deleted questions are no longer shown with the form or exported to its response spreadsheet.", strings.Join(deleted, ", ")),
+		)
+	}
+	if len(replaced) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Items Will Be Replaced",
+			fmt.Sprintf("The question type of %s changed, so this apply deletes them and re-creates them at the same position with a new google_item_id. Responses already collected for the deleted questions are no longer shown with the form or exported to its response spreadsheet.", strings.Join(replaced, ", ")),
+		)
+	}
+
+	value, diags := types.ListValueFrom(ctx, plannedItemOperationObjectType(), planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("planned_item_operations"), value)...)
+}
+
+// plannedItemOperations returns the item operations an apply of plan over
+// state will perform; resuming reports an incomplete previous apply, which
+// the update finishes with targeted requests. ok is false when they cannot be known at plan time, for
+// example with content_json or unknown items, or when the targeted planner
+// fails (the apply reports the error).
+func plannedItemOperations(
+	ctx context.Context,
+	plan FormResourceModel,
+	state *FormResourceModel,
+	resuming bool,
+) (ops []PlannedItemOperationModel, ok bool) {
+	if plan.Items.IsUnknown() || (!plan.ContentJSON.IsNull() && plan.ContentJSON.ValueString() != "") {
+		return nil, false
+	}
+	desired, d := tfItemsToConvertItems(ctx, plan.Items)
+	if d.HasError() {
+		return nil, false
+	}
+
+	if state == nil {
+		ops = make([]PlannedItemOperationModel, 0, len(desired))
+		for i, it := range desired {
+			ops = append(ops, itemOperation(itemOpCreate, it.ItemKey, "", i))
+		}
+		return ops, true
+	}
+
+	if !state.ContentJSON.IsNull() && state.ContentJSON.ValueString() != "" {
+		return nil, false
+	}
+	current, idToKey, ok := stateItemsAsFormItems(ctx, state.Items)
+	if !ok {
+		return nil, false
+	}
+
+	if itemUpdateStrategy(plan, resuming) != "targeted" {
+		// replace_all deletes and re-creates every item.
+		for i := len(current) - 1; i >= 0; i-- {
+			ops = append(ops, itemOperation(itemOpDelete, idToKey[current[i].ItemId], current[i].ItemId, i))
+		}
+		for i, it := range desired {
+			ops = append(ops, itemOperation(itemOpCreate, it.ItemKey, "", i))
+		}
+		return ops, true
+	}
+
+	input, d := targetedUpdateInput(ctx, plan, *state, current)
+	if d.HasError() {
+		return nil, false
+	}
+	itemPlan, err := convert.PlanTargetedUpdate(input)
+	if err != nil {
+		return nil, false
+	}
+	return describeTargetedPlan(itemPlan, current, idToKey), true
+}
+
+// stateItemsAsFormItems rebuilds the form's items from state, as the
+// targeted planner sees them. idToKey maps their IDs to item_keys. Items
+// without google_item_id were not created by an incomplete create and are
+// left out.
+func stateItemsAsFormItems(ctx context.Context, items types.List) ([]*forms.Item, map[string]string, bool) {
+	if items.IsUnknown() {
+		return nil, nil, false
+	}
+	stateItems, d := tfItemsToConvertItems(ctx, items)
+	if d.HasError() {
+		return nil, nil, false
+	}
+	current := make([]*forms.Item, 0, len(stateItems))
+	idToKey := make(map[string]string, len(stateItems))
+	for i, it := range stateItems {
+		if it.GoogleItemID == "" {
+			continue
+		}
+		req, err := convert.ItemModelToCreateRequest(it, i)
+		if err != nil {
+			return nil, nil, false
+		}
+		item := req.CreateItem.Item
+		item.ItemId = it.GoogleItemID
+		current = append(current, item)
+		idToKey[it.GoogleItemID] = it.ItemKey
+	}
+	return current, idToKey, true
+}
+
+// describeTargetedPlan lists the operations of a targeted plan in request
+// order. Indices are those at the time each request is applied.
+func describeTargetedPlan(plan *convert.TargetedPlan, current []*forms.Item, idToKey map[string]string) []PlannedItemOperationModel {
+	ops := []PlannedItemOperationModel{}
+
+	order := make([]string, len(current))
+	for i, it := range current {
+		order[i] = it.ItemId
+	}
+
+	// Deletes are planned against the original indices, highest first.
+	for _, req := range plan.Deletes {
+		idx := int(req.DeleteItem.Location.Index)
+		id := current[idx].ItemId
+		ops = append(ops, itemOperation(itemOpDelete, idToKey[id], id, idx))
+		order = append(order[:idx], order[idx+1:]...)
+	}
+
+	for _, req := range plan.Moves {
+		from := int(req.MoveItem.OriginalLocation.Index)
+		to := int(req.MoveItem.NewLocation.Index)
+		id := order[from]
+		order = append(order[:from], order[from+1:]...)
+		order = append(order[:to], append([]string{id}, order[to:]...)...)
+		ops = append(ops, itemOperation(itemOpMove, idToKey[id], id, to))
+	}
+
+	for _, req := range plan.Updates {
+		id := req.UpdateItem.Item.ItemId
+		ops = append(ops, itemOperation(itemOpUpdate, idToKey[id], id, int(req.UpdateItem.Location.Index)))
+	}
+
+	replaced := 0
+	for _, req := range plan.Replacements {
+		if req.CreateItem == nil {
+			continue
+		}
+		idx := int(req.CreateItem.Location.Index)
+		ops = append(ops, itemOperation(itemOpReplace, plan.ReplaceKeys[replaced], order[idx], idx))
+		replaced++
+	}
+
+	for i, req := range plan.Creates {
+		ops = append(ops, itemOperation(itemOpCreate, plan.CreateKeys[i], "", int(req.CreateItem.Location.Index)))
+	}
+	return ops
+}
+
+func itemOperation(op, key, googleID string, index int) PlannedItemOperationModel {
+	m := PlannedItemOperationModel{
+		Operation:    types.StringValue(op),
+		ItemKey:      types.StringValue(key),
+		GoogleItemID: types.StringNull(),
+		Index:        types.Int64Value(int64(index)),
+	}
+	if googleID != "" {
+		m.GoogleItemID = types.StringValue(googleID)
+	}
+	return m
+}
+
+// describeItem names an item in a diagnostic.
+func describeItem(op PlannedItemOperationModel) string {
+	if key := op.ItemKey.ValueString(); key != "" {
+		return fmt.Sprintf("%q", key)
+	}
+	return "item " + op.GoogleItemID.ValueString()
+}
+
+// plannedItemOperationsForState returns the planned_item_operations value to
+// record in state: the value planned for the last apply when it was known.
+func plannedItemOperationsForState(plan types.List) types.List {
+	if plan.IsNull() || plan.IsUnknown() {
+		return noPlannedItemOperations()
+	}
+	return plan
+}
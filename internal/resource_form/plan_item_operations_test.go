@@ -0,0 +1,334 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func withGoogleItemID(t *testing.T, item tftypes.Value, gid string) tftypes.Value {
+	t.Helper()
+	var attrs map[string]tftypes.Value
+	if err := item.As(&attrs); err != nil {
+		t.Fatalf("item.As: %v", err)
+	}
+	attrs["google_item_id"] = tftypes.NewValue(tftypes.String, gid)
+	return tftypes.NewValue(item.Type(), attrs)
+}
+
+func unknownPlannedItemOperations(t *testing.T) tftypes.Value {
+	t.Helper()
+	objType, ok := testSchemaResp().Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected tftypes.Object schema type")
+	}
+	return tftypes.NewValue(objType.AttributeTypes["planned_item_operations"], tftypes.UnknownValue)
+}
+
+func runModifyPlan(t *testing.T, plan, state map[string]tftypes.Value) ([]PlannedItemOperationModel, *resource.ModifyPlanResponse) {
+	t.Helper()
+	ctx := context.Background()
+	r := testResource(&testutil.MockFormsAPI{}, &testutil.MockDriveAPI{})
+
+	req := resource.ModifyPlanRequest{Plan: buildPlan(t, plan), State: emptyState(t)}
+	if state != nil {
+		req.State = buildState(t, state)
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+
+	var ops types.List
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("planned_item_operations"), &ops)...)
+	if ops.IsUnknown() || ops.IsNull() {
+		return nil, resp
+	}
+	var out []PlannedItemOperationModel
+	resp.Diagnostics.Append(ops.ElementsAs(ctx, &out, false)...)
+	return out, resp
+}
+
+func formatOps(ops []PlannedItemOperationModel) []string {
+	out := make([]string, 0, len(ops))
+	for _, op := range ops {
+		s := op.Operation.ValueString() + " " + op.ItemKey.ValueString()
+		if !op.GoogleItemID.IsNull() {
+			s += "(" + op.GoogleItemID.ValueString() + ")"
+		}
+		s += " @" + strconv.FormatInt(op.Index.ValueInt64(), 10)
+		out = append(out, s)
+	}
+	return out
+}
+
+func TestModifyPlan_Create_AllItemsCreated(t *testing.T) {
+	t.Parallel()
+
+	ops, resp := runModifyPlan(t, map[string]tftypes.Value{
+		"title":                   tftypes.NewValue(tftypes.String, "New"),
+		"item":                    itemListVal(t, saItem(t, "q1", "Name?", nil), saItem(t, "q2", "Email?", nil)),
+		"planned_item_operations": unknownPlannedItemOperations(t),
+	}, nil)
+
+	got := strings.Join(formatOps(ops), ", ")
+	if want := "create q1 @0, create q2 @1"; got != want {
+		t.Errorf("planned_item_operations = %q, want %q", got, want)
+	}
+	if resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("expected no warnings, got %v", resp.Diagnostics.Warnings())
+	}
+}
+
+func TestModifyPlan_Targeted_DescribesOperations(t *testing.T) {
+	t.Parallel()
+
+	state := map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "form-id"),
+		"title":           tftypes.NewValue(tftypes.String, "Form"),
+		"update_strategy": tftypes.NewValue(tftypes.String, "targeted"),
+		"manage_mode":     tftypes.NewValue(tftypes.String, "all"),
+		"item": itemListVal(t,
+			withGoogleItemID(t, saItem(t, "q1", "Name?", nil), "gid_1"),
+			withGoogleItemID(t, saItem(t, "q2", "Email?", nil), "gid_2"),
+			withGoogleItemID(t, saItem(t, "q3", "Old?", nil), "gid_3"),
+		),
+	}
+	plan := map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "form-id"),
+		"title":           tftypes.NewValue(tftypes.String, "Form"),
+		"update_strategy": tftypes.NewValue(tftypes.String, "targeted"),
+		"manage_mode":     tftypes.NewValue(tftypes.String, "all"),
+		"item": itemListVal(t,
+			saItem(t, "q2", "Email?", nil),
+			saItem(t, "q1", "Your name?", nil),
+			saItem(t, "q4", "Phone?", nil),
+		),
+		"planned_item_operations": unknownPlannedItemOperations(t),
+	}
+
+	ops, resp := runModifyPlan(t, plan, state)
+
+	got := formatOps(ops)
+	joined := strings.Join(got, ", ")
+	for _, want := range []string{
+		"delete q3(gid_3) @2",
+		"update q1(gid_1) @1",
+		"create q4 @2",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("planned_item_operations = %q, missing %q", joined, want)
+		}
+	}
+	if !strings.Contains(joined, "move ") {
+		t.Errorf("planned_item_operations = %q, expected a move", joined)
+	}
+	if got[0] != "delete q3(gid_3) @2" {
+		t.Errorf("first operation = %q, want the delete", got[0])
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %v", resp.Diagnostics.Warnings())
+	}
+	w := resp.Diagnostics.Warnings()[0]
+	if w.Summary() != "Items Will Be Deleted" || !strings.Contains(w.Detail(), `"q3"`) {
+		t.Errorf("unexpected warning: %s -- %s", w.Summary(), w.Detail())
+	}
+}
+
+func TestModifyPlan_ReplaceAll_RecreatesItems(t *testing.T) {
+	t.Parallel()
+
+	state := map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "form-id"),
+		"title":           tftypes.NewValue(tftypes.String, "Form"),
+		"update_strategy": tftypes.NewValue(tftypes.String, "replace_all"),
+		"item": itemListVal(t,
+			withGoogleItemID(t, saItem(t, "q1", "Name?", nil), "gid_1"),
+		),
+	}
+	plan := map[string]tftypes.Value{
+		"id":                      tftypes.NewValue(tftypes.String, "form-id"),
+		"title":                   tftypes.NewValue(tftypes.String, "Form"),
+		"update_strategy":         tftypes.NewValue(tftypes.String, "replace_all"),
+		"item":                    itemListVal(t, saItem(t, "q1", "Your name?", nil)),
+		"planned_item_operations": unknownPlannedItemOperations(t),
+	}
+
+	ops, resp := runModifyPlan(t, plan, state)
+
+	got := strings.Join(formatOps(ops), ", ")
+	if want := "delete q1(gid_1) @0, create q1 @0"; got != want {
+		t.Errorf("planned_item_operations = %q, want %q", got, want)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected 1 warning, got %v", resp.Diagnostics.Warnings())
+	}
+}
+
+func TestModifyPlan_Targeted_WarnsAboutReplacements(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		strategy    string
+		allow       bool
+		wantSummary string
+	}{
+		{name: "targeted_allowed", strategy: "targeted", allow: true, wantSummary: "Items Will Be Replaced"},
+		// The apply refuses the type change, so there is nothing to preview.
+		{name: "targeted_not_allowed", strategy: "targeted"},
+		{name: "replace_all", strategy: "replace_all", allow: true, wantSummary: "Items Will Be Deleted"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			vals := func(items tftypes.Value) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"id":                          tftypes.NewValue(tftypes.String, "form-id"),
+					"title":                       tftypes.NewValue(tftypes.String, "Form"),
+					"update_strategy":             tftypes.NewValue(tftypes.String, tc.strategy),
+					"manage_mode":                 tftypes.NewValue(tftypes.String, "all"),
+					"allow_item_type_replacement": tftypes.NewValue(tftypes.Bool, tc.allow),
+					"item":                        items,
+				}
+			}
+			state := vals(itemListVal(t,
+				withGoogleItemID(t, saItem(t, "name", "Name?", nil), "gid_1"),
+				withGoogleItemID(t, paraItem(t, "bio", "Bio?", nil), "gid_2"),
+			))
+			plan := vals(itemListVal(t,
+				paraItem(t, "name", "Name?", nil),
+				paraItem(t, "bio", "About you?", nil),
+			))
+			plan["planned_item_operations"] = unknownPlannedItemOperations(t)
+
+			ops, resp := runModifyPlan(t, plan, state)
+
+			warnings := resp.Diagnostics.Warnings()
+			if tc.wantSummary == "" {
+				if len(warnings) != 0 || ops != nil {
+					t.Fatalf("unexpected preview %v with warnings %v", formatOps(ops), warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != tc.wantSummary {
+				t.Fatalf("warnings = %v, want one %q", warnings, tc.wantSummary)
+			}
+			detail := warnings[0].Detail()
+			if !strings.Contains(detail, `"name"`) {
+				t.Errorf("warning should name the replaced item: %s", detail)
+			}
+			if tc.strategy == "targeted" {
+				if strings.Contains(detail, `"bio"`) || !strings.Contains(detail, "deletes them and re-creates them") {
+					t.Errorf("warning should describe only the replaced item as deleted and re-created: %s", detail)
+				}
+				if got := strings.Join(formatOps(ops), ", "); !strings.Contains(got, "replace name(gid_1) @0") {
+					t.Errorf("planned_item_operations = %q, want a replace of name", got)
+				}
+			}
+		})
+	}
+}
+
+func TestModifyPlan_ContentJSON_LeavesUnknown(t *testing.T) {
+	t.Parallel()
+
+	ops, resp := runModifyPlan(t, map[string]tftypes.Value{
+		"title":                   tftypes.NewValue(tftypes.String, "New"),
+		"content_json":            tftypes.NewValue(tftypes.String, `[{"title":"Q","questionItem":{"question":{"textQuestion":{}}}}]`),
+		"planned_item_operations": unknownPlannedItemOperations(t),
+	}, nil)
+
+	if ops != nil {
+		t.Errorf("expected planned_item_operations to stay unknown, got %v", formatOps(ops))
+	}
+	if resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("expected no warnings, got %v", resp.Diagnostics.Warnings())
+	}
+}
+
+func TestModifyPlan_Targeted_CreatesAtPlannedIndex(t *testing.T) {
+	t.Parallel()
+
+	vals := func(items tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"id":                    tftypes.NewValue(tftypes.String, "form-id"),
+			"title":                 tftypes.NewValue(tftypes.String, "Form"),
+			"update_strategy":       tftypes.NewValue(tftypes.String, "targeted"),
+			"unmanaged_item_policy": tftypes.NewValue(tftypes.String, "keep"),
+			"item":                  items,
+		}
+	}
+	state := vals(itemListVal(t,
+		withGoogleItemID(t, saItem(t, "q1", "Name?", nil), "gid_1"),
+		withGoogleItemID(t, saItem(t, "q2", "Email?", nil), "gid_2"),
+	))
+	plan := vals(itemListVal(t,
+		saItem(t, "q1", "Name?", nil),
+		saItem(t, "new", "Phone?", nil),
+		saItem(t, "q2", "Email?", nil),
+	))
+	plan["planned_item_operations"] = unknownPlannedItemOperations(t)
+
+	ops, _ := runModifyPlan(t, plan, state)
+
+	// Like the apply, only manage_mode = "partial" appends new items.
+	if got, want := strings.Join(formatOps(ops), ", "), "create new @1"; got != want {
+		t.Errorf("planned_item_operations = %q, want %q", got, want)
+	}
+}
+
+func TestModifyPlan_ResumingCreate_PreviewsRemainingItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := testResource(&testutil.MockFormsAPI{}, &testutil.MockDriveAPI{})
+
+	// An incomplete create saved the plan with q2 not created yet.
+	vals := func(items tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"id":    tftypes.NewValue(tftypes.String, "form-id"),
+			"title": tftypes.NewValue(tftypes.String, "Form"),
+			"item":  items,
+		}
+	}
+	state := buildState(t, vals(itemListVal(t,
+		withGoogleItemID(t, saItem(t, "q1", "Name?", nil), "gid_1"),
+		saItem(t, "q2", "Email?", nil),
+	)))
+	plan := buildPlan(t, vals(itemListVal(t,
+		saItem(t, "q1", "Name?", nil),
+		saItem(t, "q2", "Email?", nil),
+	)))
+
+	req := resource.ModifyPlanRequest{Plan: plan, State: state}
+	initPrivate(&req.Private)
+	req.Private.SetKey(ctx, privateKeyIncompleteApply, []byte("true"))
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+
+	// The update resumes with targeted requests instead of replacing all
+	// items.
+	var ops types.List
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("planned_item_operations"), &ops)...)
+	var out []PlannedItemOperationModel
+	resp.Diagnostics.Append(ops.ElementsAs(ctx, &out, false)...)
+	if got, want := strings.Join(formatOps(out), ", "), "create q2 @1"; got != want {
+		t.Errorf("planned_item_operations = %q, want %q", got, want)
+	}
+}
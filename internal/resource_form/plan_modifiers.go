@@ -6,13 +6,18 @@ package resourceform
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/45ck/terraform-provider-googleforms/internal/convert"
 )
 
-// Compile-time interface check.
-var _ planmodifier.String = ContentJSONHashModifier{}
+// Compile-time interface checks.
+var (
+	_ planmodifier.String = ContentJSONHashModifier{}
+	_ planmodifier.List   = ContentJSONItemIDsModifier{}
+)
 
 // ContentJSONHashModifier suppresses diffs for content_json when the
 // normalized JSON content is semantically equivalent. It computes a SHA-256
@@ -56,3 +61,54 @@ func (m ContentJSONHashModifier) PlanModifyString(
 		resp.PlanValue = req.StateValue
 	}
 }
+
+// ContentJSONItemIDsModifier keeps content_json_item_ids from state when the
+// apply cannot change them: content_json is unchanged, its items still exist
+// and items are updated with update_strategy = "targeted", which keeps the IDs
+// of unchanged items.
+// replace_all re-creates every content_json item on any update, so the IDs
+// stay unknown there.
+type ContentJSONItemIDsModifier struct{}
+
+func (m ContentJSONItemIDsModifier) Description(_ context.Context) string {
+	return "Uses the state value when content_json and the item IDs do not change."
+}
+
+func (m ContentJSONItemIDsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyList copies the state value when content_json is unchanged.
+func (m ContentJSONItemIDsModifier) PlanModifyList(
+	ctx context.Context,
+	req planmodifier.ListRequest,
+	resp *planmodifier.ListResponse,
+) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planJSON, stateJSON, strategy types.String
+	var allow types.Bool
+	if d := req.Plan.GetAttribute(ctx, path.Root("content_json"), &planJSON); d.HasError() {
+		return
+	}
+	if d := req.State.GetAttribute(ctx, path.Root("content_json"), &stateJSON); d.HasError() {
+		return
+	}
+	if d := req.Plan.GetAttribute(ctx, path.Root("update_strategy"), &strategy); d.HasError() {
+		return
+	}
+	if d := req.Plan.GetAttribute(ctx, path.Root("allow_item_type_replacement"), &allow); d.HasError() {
+		return
+	}
+	if planJSON.IsUnknown() || !planJSON.Equal(stateJSON) {
+		return
+	}
+	// Read nulls the IDs when items were deleted outside Terraform, and type
+	// replacements give items new IDs.
+	if !planJSON.IsNull() && (strategy.ValueString() != "targeted" || req.StateValue.IsNull() || allow.ValueBool()) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
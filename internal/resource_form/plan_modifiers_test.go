@@ -7,8 +7,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestContentJSONHashModifier_Description(t *testing.T) {
@@ -176,3 +178,62 @@ func TestContentJSONHashModifier_ImplementsInterface(t *testing.T) {
 	t.Parallel()
 	var _ planmodifier.String = ContentJSONHashModifier{}
 }
+
+func TestContentJSONItemIDsModifier(t *testing.T) {
+	t.Parallel()
+
+	idsType := tftypes.List{ElementType: tftypes.String}
+	storedIDs := tftypes.NewValue(idsType, []tftypes.Value{tftypes.NewValue(tftypes.String, "gid_1")})
+	const stored = `[{"title":"Q1","textItem":{}}]`
+
+	for _, tc := range []struct {
+		name      string
+		planJSON  string
+		strategy  string
+		allow     bool
+		stateIDs  tftypes.Value
+		wantState bool
+	}{
+		{name: "unchanged targeted", planJSON: stored, strategy: "targeted", stateIDs: storedIDs, wantState: true},
+		{name: "changed content_json", planJSON: `[{"title":"Q2","textItem":{}}]`, strategy: "targeted", stateIDs: storedIDs},
+		{name: "replace_all", planJSON: stored, strategy: "replace_all", stateIDs: storedIDs},
+		{name: "type replacement allowed", planJSON: stored, strategy: "targeted", allow: true, stateIDs: storedIDs},
+		{name: "ids cleared by read", planJSON: stored, strategy: "targeted", stateIDs: tftypes.NewValue(idsType, nil)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			state := buildState(t, map[string]tftypes.Value{
+				"content_json":          tftypes.NewValue(tftypes.String, stored),
+				"content_json_item_ids": tc.stateIDs,
+			})
+			plan := buildPlan(t, map[string]tftypes.Value{
+				"content_json":                tftypes.NewValue(tftypes.String, tc.planJSON),
+				"update_strategy":             tftypes.NewValue(tftypes.String, tc.strategy),
+				"allow_item_type_replacement": tftypes.NewValue(tftypes.Bool, tc.allow),
+				"content_json_item_ids":       tftypes.NewValue(idsType, tftypes.UnknownValue),
+			})
+
+			var stateValue types.List
+			if d := state.GetAttribute(ctx, path.Root("content_json_item_ids"), &stateValue); d.HasError() {
+				t.Fatalf("state: %v", d)
+			}
+			req := planmodifier.ListRequest{
+				State:      state,
+				Plan:       plan,
+				StateValue: stateValue,
+				PlanValue:  types.ListUnknown(types.StringType),
+			}
+			resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+			ContentJSONItemIDsModifier{}.PlanModifyList(ctx, req, resp)
+
+			if tc.wantState && !resp.PlanValue.Equal(stateValue) {
+				t.Errorf("plan = %s, want the state value %s", resp.PlanValue, stateValue)
+			}
+			if !tc.wantState && !resp.PlanValue.IsUnknown() {
+				t.Errorf("plan = %s, want unknown", resp.PlanValue)
+			}
+		})
+	}
+}
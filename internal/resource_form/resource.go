@@ -16,6 +16,7 @@ var (
 	_ resource.Resource                     = &FormResource{}
 	_ resource.ResourceWithImportState      = &FormResource{}
 	_ resource.ResourceWithConfigValidators = &FormResource{}
+	_ resource.ResourceWithModifyPlan       = &FormResource{}
 )
 
 // FormResource implements the googleforms_form Terraform resource.
@@ -72,5 +73,11 @@ func (r *FormResource) ConfigValidators(
 		ChoiceOptionNavigationValidator{},
 		CorrectAnswerInOptionsValidator{},
 		GradingRequiresQuizValidator{},
+		ItemCountLimitValidator{},
+		ChoiceCountLimitValidator{},
+		TextLengthLimitValidator{},
+		ScaleAndRatingLimitValidator{},
+		QuizPointsLimitValidator{},
+		YouTubeURIValidator{},
 	}
 }
@@ -0,0 +1,244 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// responseProtection returns the response_protection mode, "off" when unset.
+func responseProtection(m FormResourceModel) string {
+	if m.ResponseProtection.IsNull() || m.ResponseProtection.IsUnknown() || m.ResponseProtection.ValueString() == "" {
+		return "off"
+	}
+	return m.ResponseProtection.ValueString()
+}
+
+// responsesScopeDetail explains that response_protection (%q) needs the
+// read_responses provider setting.
+const responsesScopeDetail = "response_protection is %q, which lists the form's responses, but the provider does not request the forms.responses.readonly scope. Set read_responses = true in the provider configuration, or set response_protection = \"off\"."
+
+// checkResponsesScope fails plans that enable response_protection when the
+// provider cannot read responses, rather than failing the apply later.
+func (r *FormResource) checkResponsesScope(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	var mode types.String
+	diags := plan.GetAttribute(ctx, path.Root("response_protection"), &mode)
+	if diags.HasError() || r.client == nil || r.client.ReadResponses {
+		return diags
+	}
+	if m := responseProtection(FormResourceModel{ResponseProtection: mode}); m != "off" {
+		diags.AddAttributeError(path.Root("response_protection"), "Responses Scope Not Requested", fmt.Sprintf(responsesScopeDetail, m))
+	}
+	return diags
+}
+
+// acknowledgedResponseLoss returns the item_keys listed in
+// acknowledge_response_loss.
+func acknowledgedResponseLoss(ctx context.Context, m FormResourceModel) (map[string]bool, diag.Diagnostics) {
+	out := map[string]bool{}
+	if m.AcknowledgeResponseLoss.IsNull() || m.AcknowledgeResponseLoss.IsUnknown() {
+		return out, nil
+	}
+	var keys []string
+	diags := m.AcknowledgeResponseLoss.ElementsAs(ctx, &keys, false)
+	for _, k := range keys {
+		out[k] = true
+	}
+	return out, diags
+}
+
+// itemQuestionIDs returns the question IDs of an item, including the row
+// questions of a grid.
+func itemQuestionIDs(item *forms.Item) []string {
+	if item == nil {
+		return nil
+	}
+	var ids []string
+	if item.QuestionItem != nil && item.QuestionItem.Question != nil && item.QuestionItem.Question.QuestionId != "" {
+		ids = append(ids, item.QuestionItem.Question.QuestionId)
+	}
+	if item.QuestionGroupItem != nil {
+		for _, q := range item.QuestionGroupItem.Questions {
+			if q != nil && q.QuestionId != "" {
+				ids = append(ids, q.QuestionId)
+			}
+		}
+	}
+	return ids
+}
+
+// guardItemResponseLoss checks the items an update deletes or re-creates
+// (removed, by Google item ID) for answers. Unless the item's item_key is
+// listed in acknowledge_response_loss, an answered item is an error with
+// response_protection = "block" and a warning with "warn". idToKey maps
+// Google item IDs to item_keys.
+func (r *FormResource) guardItemResponseLoss(
+	ctx context.Context,
+	plan FormResourceModel,
+	formID string,
+	current []*forms.Item,
+	removed map[string]bool,
+	idToKey map[string]string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mode := responseProtection(plan)
+	if mode == "off" || len(removed) == 0 {
+		return diags
+	}
+
+	acknowledged, d := acknowledgedResponseLoss(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only ask for responses when an unacknowledged question is at stake.
+	var candidates []*forms.Item
+	for _, it := range current {
+		if it == nil || !removed[it.ItemId] || acknowledged[idToKey[it.ItemId]] {
+			continue
+		}
+		if len(itemQuestionIDs(it)) > 0 {
+			candidates = append(candidates, it)
+		}
+	}
+	if len(candidates) == 0 {
+		return diags
+	}
+
+	if !r.client.ReadResponses {
+		diags.AddError("Responses Scope Not Requested", fmt.Sprintf(responsesScopeDetail, mode))
+		return diags
+	}
+	responses, err := r.client.Forms.ListResponses(ctx, formID)
+	if err != nil {
+		diags.AddError(
+			"Error Checking Form Responses",
+			fmt.Sprintf("response_protection is %q but the responses of form %s could not be listed: %s", mode, formID, err),
+		)
+		return diags
+	}
+	counts := answerCounts(responses)
+
+	var answered []string
+	for _, it := range candidates {
+		n := 0
+		for _, qid := range itemQuestionIDs(it) {
+			n += counts[qid]
+		}
+		if n == 0 {
+			continue
+		}
+		name := fmt.Sprintf("item %s", it.ItemId)
+		if key := idToKey[it.ItemId]; key != "" {
+			name = fmt.Sprintf("%q", key)
+		}
+		answered = append(answered, fmt.Sprintf("%s (%d answers)", name, n))
+	}
+	if len(answered) == 0 {
+		return diags
+	}
+	sort.Strings(answered)
+
+	detail := fmt.Sprintf(
+		"This apply deletes or re-creates questions of form %s that already have answers: %s. Their answers are no longer shown with the form. Add the item_keys to acknowledge_response_loss to proceed.",
+		formID, strings.Join(answered, ", "),
+	)
+	if mode == "block" {
+		diags.AddError("Answered Items Would Be Deleted", detail)
+	} else {
+		diags.AddWarning("Answered Items Will Be Deleted", detail)
+	}
+	return diags
+}
+
+// removedItemIDs returns the IDs of the current items a targeted plan deletes
+// or re-creates.
+func removedItemIDs(in convert.TargetedInput, plan *convert.TargetedPlan) map[string]bool {
+	kept := make(map[string]bool, len(plan.KeyToID))
+	for _, id := range plan.KeyToID {
+		kept[id] = true
+	}
+	removed := map[string]bool{}
+	for _, it := range in.Current {
+		if it == nil || it.ItemId == "" || kept[it.ItemId] {
+			continue
+		}
+		if in.Partial && !in.Managed[it.ItemId] {
+			continue
+		}
+		removed[it.ItemId] = true
+	}
+	return removed
+}
+
+// guardFormResponseLoss refuses (response_protection = "block") or warns
+// about (response_protection = "warn") deleting a form that has responses.
+func (r *FormResource) guardFormResponseLoss(ctx context.Context, state FormResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mode := responseProtection(state)
+	if mode == "off" {
+		return diags
+	}
+
+	if !r.client.ReadResponses {
+		diags.AddError("Responses Scope Not Requested", fmt.Sprintf(responsesScopeDetail, mode))
+		return diags
+	}
+	formID := state.ID.ValueString()
+	responses, err := r.client.Forms.ListResponses(ctx, formID)
+	if client.IsNotFound(err) {
+		// Already deleted; Delete treats this as success.
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Checking Form Responses",
+			fmt.Sprintf("response_protection is %q but the responses of form %s could not be listed: %s", mode, formID, err),
+		)
+		return diags
+	}
+	if len(responses) == 0 {
+		return diags
+	}
+
+	if mode == "block" {
+		diags.AddError(
+			"Form Has Responses",
+			fmt.Sprintf("Form %s has %d responses and response_protection is \"block\". Set response_protection to \"warn\" or \"off\" and apply before destroying the form.", formID, len(responses)),
+		)
+	} else {
+		diags.AddWarning(
+			"Deleting Form With Responses",
+			fmt.Sprintf("Form %s has %d responses, which are removed along with the form.", formID, len(responses)),
+		)
+	}
+	return diags
+}
+
+// answerCounts returns the number of answers per question ID.
+func answerCounts(responses []*forms.FormResponse) map[string]int {
+	counts := map[string]int{}
+	for _, resp := range responses {
+		if resp == nil {
+			continue
+		}
+		for qid := range resp.Answers {
+			counts[qid]++
+		}
+	}
+	return counts
+}
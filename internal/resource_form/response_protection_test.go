@@ -0,0 +1,216 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+// answeredForm returns formWithItems with question IDs, and responses that
+// answer the "Color?" question.
+func answeredForm(id string) (*forms.Form, []*forms.FormResponse) {
+	f := formWithItems(id, "Answered")
+	f.Items[0].QuestionItem.Question.QuestionId = "q_name"
+	f.Items[1].QuestionItem.Question.QuestionId = "q_color"
+	responses := []*forms.FormResponse{
+		{ResponseId: "r1", Answers: map[string]forms.Answer{"q_color": {QuestionId: "q_color"}}},
+		{ResponseId: "r2", Answers: map[string]forms.Answer{"q_color": {QuestionId: "q_color"}}},
+	}
+	return f, responses
+}
+
+func runProtectedItemDelete(t *testing.T, acknowledge []string) (*resource.UpdateResponse, int) {
+	t.Helper()
+
+	form, responses := answeredForm("protected-form")
+	batchCalls := 0
+	mockForms := &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, _ string) (*forms.Form, error) {
+			return form, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, _ *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			batchCalls++
+			return &forms.BatchUpdateFormResponse{}, nil
+		},
+		ListResponsesFunc: func(_ context.Context, _ string) ([]*forms.FormResponse, error) {
+			return responses, nil
+		},
+	}
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+
+	common := map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "protected-form"),
+		"title":               tftypes.NewValue(tftypes.String, "Answered"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"update_strategy":     tftypes.NewValue(tftypes.String, "targeted"),
+		"response_protection": tftypes.NewValue(tftypes.String, "block"),
+	}
+	stateVals := map[string]tftypes.Value{
+		"item": itemListVal(t,
+			withGoogleItemID(t, saItem(t, "name", "Name?", nil), "gid_1"),
+			withGoogleItemID(t, mcItem(t, "color", "Color?", []string{"Red", "Blue"}, nil), "gid_2"),
+		),
+	}
+	planVals := map[string]tftypes.Value{
+		"item": itemListVal(t, saItem(t, "name", "Name?", nil)),
+	}
+	if acknowledge != nil {
+		keys := make([]tftypes.Value, len(acknowledge))
+		for i, k := range acknowledge {
+			keys[i] = tftypes.NewValue(tftypes.String, k)
+		}
+		planVals["acknowledge_response_loss"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, keys)
+	}
+	for k, v := range common {
+		stateVals[k] = v
+		planVals[k] = v
+	}
+
+	state := buildState(t, stateVals)
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: buildPlan(t, planVals), State: state}, resp)
+	return resp, batchCalls
+}
+
+func TestUpdate_ResponseProtectionBlock_RefusesAnsweredItemDelete(t *testing.T) {
+	t.Parallel()
+
+	resp, batchCalls := runProtectedItemDelete(t, nil)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when deleting an answered item")
+	}
+	d := resp.Diagnostics.Errors()[0]
+	if d.Summary() != "Answered Items Would Be Deleted" || !strings.Contains(d.Detail(), `"color" (2 answers)`) {
+		t.Errorf("unexpected diagnostic: %s -- %s", d.Summary(), d.Detail())
+	}
+	if batchCalls != 0 {
+		t.Errorf("expected no BatchUpdate calls, got %d", batchCalls)
+	}
+}
+
+func TestUpdate_ResponseProtectionBlock_AcknowledgedItemDeleted(t *testing.T) {
+	t.Parallel()
+
+	resp, batchCalls := runProtectedItemDelete(t, []string{"color"})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if batchCalls != 1 {
+		t.Errorf("expected 1 BatchUpdate call, got %d", batchCalls)
+	}
+}
+
+func TestDelete_ResponseProtection(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		mode        string
+		wantError   bool
+		wantDeleted bool
+	}{
+		{mode: "block", wantError: true},
+		{mode: "warn", wantDeleted: true},
+		{mode: "off", wantDeleted: true},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			t.Parallel()
+
+			_, responses := answeredForm("protected-form")
+			listCalls := 0
+			deleted := false
+			mockForms := &testutil.MockFormsAPI{
+				ListResponsesFunc: func(_ context.Context, _ string) ([]*forms.FormResponse, error) {
+					listCalls++
+					return responses, nil
+				},
+			}
+			mockDrive := &testutil.MockDriveAPI{
+				TrashFunc: func(_ context.Context, _ string, _ bool) error {
+					deleted = true
+					return nil
+				},
+			}
+			r := testResource(mockForms, mockDrive)
+
+			state := buildState(t, map[string]tftypes.Value{
+				"id":                  tftypes.NewValue(tftypes.String, "protected-form"),
+				"title":               tftypes.NewValue(tftypes.String, "Answered"),
+				"response_protection": tftypes.NewValue(tftypes.String, tc.mode),
+			})
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("HasError() = %v, want %v: %v", resp.Diagnostics.HasError(), tc.wantError, resp.Diagnostics)
+			}
+			if deleted != tc.wantDeleted {
+				t.Errorf("deleted = %v, want %v", deleted, tc.wantDeleted)
+			}
+			if tc.mode == "off" && listCalls != 0 {
+				t.Errorf("expected no ListResponses calls with response_protection off, got %d", listCalls)
+			}
+			if tc.mode == "warn" && resp.Diagnostics.WarningsCount() != 1 {
+				t.Errorf("expected 1 warning, got %v", resp.Diagnostics.Warnings())
+			}
+		})
+	}
+}
+
+func TestResponseProtection_RequiresReadResponses(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{"block", "warn", "off"} {
+		t.Run(mode, func(t *testing.T) {
+			t.Parallel()
+
+			listCalls := 0
+			mockForms := &testutil.MockFormsAPI{
+				ListResponsesFunc: func(_ context.Context, _ string) ([]*forms.FormResponse, error) {
+					listCalls++
+					return nil, nil
+				},
+			}
+			r := testResource(mockForms, &testutil.MockDriveAPI{TrashFunc: func(_ context.Context, _ string, _ bool) error { return nil }})
+			r.client.ReadResponses = false
+
+			vals := map[string]tftypes.Value{
+				"id":                  tftypes.NewValue(tftypes.String, "protected-form"),
+				"title":               tftypes.NewValue(tftypes.String, "Answered"),
+				"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+				"response_protection": tftypes.NewValue(tftypes.String, mode),
+			}
+			plan := buildPlan(t, vals)
+			planResp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan, State: emptyState(t)}, planResp)
+
+			state := buildState(t, vals)
+			deleteResp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, deleteResp)
+
+			if mode == "off" {
+				if planResp.Diagnostics.HasError() || deleteResp.Diagnostics.HasError() {
+					t.Errorf("unexpected errors: %v %v", planResp.Diagnostics, deleteResp.Diagnostics)
+				}
+				return
+			}
+			expectErrorContains(t, planResp.Diagnostics, "read_responses = true")
+			expectErrorContains(t, deleteResp.Diagnostics, "read_responses = true")
+			if listCalls != 0 {
+				t.Errorf("expected no ListResponses calls, got %d", listCalls)
+			}
+		})
+	}
+}
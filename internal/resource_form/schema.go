@@ -7,7 +7,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -84,7 +86,7 @@ func formAttributes() map[string]schema.Attribute {
 			Optional:    true,
 			Computed:    true,
 			Default:     stringdefault.StaticString("replace_all"),
-			Description: "Update strategy for form items. 'replace_all' deletes and recreates all items on changes. 'targeted' applies deletes/moves/updates/creates using batchUpdate when item_keys are already correlated to google_item_id in state; it refuses question type changes and does not support content_json.",
+			Description: "Update strategy for form items. 'replace_all' deletes and recreates all items on changes. 'targeted' applies deletes/moves/updates/creates using batchUpdate when item_keys are already correlated to google_item_id in state; it refuses question type changes unless allow_item_type_replacement = true. With content_json, items are correlated by an optional \"itemId\" in the JSON or by the item IDs recorded at the last apply.",
 			Validators: []validator.String{
 				stringvalidator.OneOf("replace_all", "targeted"),
 			},
@@ -95,15 +97,39 @@ func formAttributes() map[string]schema.Attribute {
 			Default:     booldefault.StaticBool(false),
 			Description: "Acknowledge that replace_all item updates can break response mappings and integrations. When false, the provider will emit warnings when replace_all is used.",
 		},
+		"allow_item_type_replacement": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "With update_strategy = \"targeted\", allow items whose question type changed to be deleted and re-created at the same index. The item_key is kept and google_item_id is refreshed; historical responses to the old question are detached from the new one.",
+		},
+		"batch_chunk_size": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(100),
+			Description: "Maximum number of item create requests sent in a single batchUpdate call when creating or updating items. Progress is saved to state after each chunk, so an interrupted apply resumes with the remaining items.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
 		"manage_mode": schema.StringAttribute{
 			Optional:    true,
 			Computed:    true,
 			Default:     stringdefault.StaticString("all"),
-			Description: "Management mode for items. 'all' treats the item list as authoritative for the whole form. 'partial' only manages the configured items (by item_key) and leaves other items untouched; in partial mode, new items are appended by default.",
+			Description: "Management mode for items. 'all' treats the item list as authoritative for the whole form. 'partial' only manages the configured items (by item_key) and leaves other items untouched; in partial mode, new items are appended by default. With content_json, partial mode requires update_strategy = \"targeted\" and only manages items created by or referenced (via \"itemId\") from the JSON.",
 			Validators: []validator.String{
 				stringvalidator.OneOf("all", "partial"),
 			},
 		},
+		"unmanaged_item_policy": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("fail"),
+			Description: "What to do with items in the form that Terraform does not manage, such as items added in the Google Forms editor, when manage_mode = \"all\". 'delete' reads them into the item list so that the plan deletes them. 'keep' leaves them in the form and lists them in unmanaged_items; it requires update_strategy = \"targeted\" while there are any. 'fail' lists them in unmanaged_items and fails the apply until they are removed or the policy is changed. The default is 'fail', so that items added in the editor are not deleted without a decision. Ignored with manage_mode = \"partial\", which always keeps them.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("delete", "keep", "fail"),
+			},
+		},
 		"partial_new_item_policy": schema.StringAttribute{
 			Optional: true,
 			Computed: true,
@@ -119,15 +145,51 @@ func formAttributes() map[string]schema.Attribute {
 			Optional:    true,
 			Computed:    true,
 			Default:     stringdefault.StaticString("overwrite"),
-			Description: "Conflict policy when the form was edited out-of-band. 'overwrite' applies changes to the latest revision. 'fail' uses write control (requiredRevisionId) and errors if the revision_id has changed since last read.",
+			Description: "Conflict policy when the form was edited out-of-band. 'overwrite' applies changes to the latest revision. 'fail' uses write control (requiredRevisionId) and errors if the revision_id has changed since last read. 'fail_on_content_drift' errors if the form's info, settings or items changed since the last apply, compared by content_hash, and lists the changes; unlike 'fail' it does not expire.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("overwrite", "fail", "fail_on_content_drift"),
+			},
+		},
+		"response_protection": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("off"),
+			Description: "Guard against losing collected responses. With 'block', an apply that deletes or re-creates (type change, replace_all) an item whose questions have answers fails unless its item_key is listed in acknowledge_response_loss, and destroying a form that has responses fails. 'warn' reports the same cases as warnings. 'off' (default) does not check responses. Checks list the form's responses, which needs read_responses = true in the provider configuration.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("off", "warn", "block"),
+			},
+		},
+		"acknowledge_response_loss": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "item_keys of answered items that may be deleted or re-created despite response_protection.",
+		},
+		"deletion_policy": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("trash"),
+			Description: "What destroying the resource does to the form. 'trash' (default) moves it to the Drive trash, from where it can be restored for 30 days. 'delete' deletes it permanently, together with its responses. 'abandon' only removes it from Terraform state.",
 			Validators: []validator.String{
-				stringvalidator.OneOf("overwrite", "fail"),
+				stringvalidator.OneOf("delete", "trash", "abandon"),
 			},
 		},
+		"deletion_protection": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "While true (the default), plans that destroy or replace the form fail. Set it to false and apply before destroying the form. Forms in state from before this attribute existed are unprotected until the next apply. A form whose create failed part-way is not protected, so that the next apply can replace it.",
+		},
 		"folder_id": schema.StringAttribute{
 			Optional:    true,
 			Description: "Drive folder ID to place the form into. If set, the provider will move the form file into this folder.",
 		},
+		"source_form_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "ID of a template form to create this form from with a Drive copy. The copy keeps what the Forms API cannot set, such as the theme and confirmation message. Copied items are adopted by item blocks of the same question type, matched by title and then by position; with manage_mode = \"all\" the other copied items are deleted. Templates the provider did not create, such as forms made in the Forms UI or shared with its account, can only be copied with read_drive_files = true in the provider configuration. Conflicts with content_json. Changing it forces a new form.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 		"supports_all_drives": schema.BoolAttribute{
 			Optional:    true,
 			Computed:    true,
@@ -149,6 +211,66 @@ func formAttributes() map[string]schema.Attribute {
 				ContentJSONHashModifier{},
 			},
 		},
+		"content_json_item_ids": schema.ListAttribute{
+			Computed:    true,
+			Description: "Google item IDs of the content_json items, by index, as of the last apply. Used to correlate content_json items with existing items for targeted updates.",
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.List{
+				ContentJSONItemIDsModifier{},
+			},
+		},
+		"unmanaged_items": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Items in the form that Terraform does not manage, in form order: items added outside Terraform since the state was last written, and items left alone by manage_mode = \"partial\" or unmanaged_item_policy = \"keep\".",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"item_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The Google item ID.",
+					},
+					"title": schema.StringAttribute{
+						Computed:    true,
+						Description: "The item title.",
+					},
+					"type": schema.StringAttribute{
+						Computed:    true,
+						Description: "The item block type, such as multiple_choice or section_header; raw_json for items without a typed block.",
+					},
+					"index": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The position of the item in the form.",
+					},
+				},
+			},
+		},
+		"planned_item_operations": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Item operations the pending apply performs, in the order they are sent: delete, move, update, replace (delete and re-create with a new ID) or create. index is the item position when the operation is applied; with manage_mode = \"partial\" positions count managed items only. Unknown when the operations depend on content_json or values known only after apply. After apply, holds the operations of the last apply.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"operation": schema.StringAttribute{
+						Computed:    true,
+						Description: "One of create, update, move, delete or replace.",
+					},
+					"item_key": schema.StringAttribute{
+						Computed:    true,
+						Description: "The item_key of the item.",
+					},
+					"google_item_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The Google item ID of an existing item. Null for created items.",
+					},
+					"index": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The position of the item when the operation is applied.",
+					},
+				},
+			},
+		},
+		"navigation_graph": schema.StringAttribute{
+			Computed:    true,
+			Description: "The section navigation of the items in the Graphviz DOT language: one node per section plus \"(start)\" and \"(submit)\", and one edge per way between them, labelled with the question and options that lead there. Null when the form has no item or section blocks, for example with content_json.",
+		},
 		"responder_uri": schema.StringAttribute{
 			Computed:    true,
 			Description: "The URL for respondents to fill out the form.",
@@ -170,6 +292,10 @@ func formAttributes() map[string]schema.Attribute {
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"content_hash": schema.StringAttribute{
+			Computed:    true,
+			Description: "SHA-256 hash of the form's info, settings and items as of the last apply. Used for conflict detection when conflict_policy = \"fail_on_content_drift\". Refreshes do not change it.",
+		},
 		"revision_id": schema.StringAttribute{
 			Computed:    true,
 			Description: "The form revision ID returned by the API (valid for ~24h). Used for conflict detection when conflict_policy = \"fail\".",
@@ -190,6 +316,58 @@ func formBlocks() map[string]schema.Block {
 				Blocks:     itemBlocks(),
 			},
 		},
+		"section": schema.ListNestedBlock{
+			Description: "A section of the form: a page break followed by its own items. Sections follow the top-level item blocks, which make up the form's first section. Conflicts with content_json and with section_header items in top-level item blocks.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"section_key": schema.StringAttribute{
+						Required:    true,
+						Description: "Unique identifier for this section, sharing the item_key namespace. Choice options reference it with go_to_section_key. Format: [a-z][a-z0-9_]{0,63}.",
+					},
+					"title": schema.StringAttribute{
+						Required:    true,
+						Description: "The section title.",
+					},
+					"description": schema.StringAttribute{
+						Optional:    true,
+						Description: "The section description.",
+					},
+					"next_section_key": schema.StringAttribute{
+						Optional:    true,
+						Description: "section_key of the section respondents go to after this one. The Forms API cannot set the destination of a section itself, so it is applied to the options without navigation of the section's multiple_choice or dropdown question; the section must have exactly one.",
+					},
+					"google_item_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The Google item ID of the section's page break.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"item": schema.ListNestedBlock{
+						Description: "A form item in this section, configured like a top-level item block.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: itemAttributes(),
+							Blocks:     itemBlocks(),
+						},
+					},
+				},
+			},
+		},
+		"archive_on_destroy": schema.SingleNestedBlock{
+			Description: "Before the form is trashed or deleted, write its definition and, when readable, its responses as new files into a Drive folder. Archiving is skipped for deletion_policy = \"abandon\".",
+			Attributes: map[string]schema.Attribute{
+				"folder_id": schema.StringAttribute{
+					Required:    true,
+					Description: "The Drive folder ID to write the archive files into.",
+				},
+				"include_responses": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to archive the form responses as JSON and CSV. Defaults to true. Responses are only readable with read_responses = true in the provider configuration.",
+				},
+			},
+		},
 	}
 }
 
@@ -674,11 +852,31 @@ func itemBlocks() map[string]schema.Block {
 				},
 			},
 		},
+		"raw_json": schema.SingleNestedBlock{
+			Description: "Escape hatch for a single item: a Forms API Item object as JSON (for features without a typed block, such as option images). The item still takes part in item_key correlation, targeted updates and moves. API-assigned fields (itemId, questionId, contentUri) are ignored.",
+			Attributes: map[string]schema.Attribute{
+				"json": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						rawItemJSONValidator{},
+					},
+					Description: "The Forms API Item as a JSON object.",
+				},
+			},
+		},
 	}
 }
 
 // gradingBlockSchema returns the grading SingleNestedBlock definition.
 func gradingBlockSchema() schema.SingleNestedBlock {
+	appliesTo := schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("correct", "incorrect", "general"),
+		},
+		Description: "Which feedback this material is attached to: correct, incorrect, or general.",
+	}
+
 	return schema.SingleNestedBlock{
 		Description: "Quiz grading options. Requires quiz = true on the form.",
 		Attributes: map[string]schema.Attribute{
@@ -690,6 +888,15 @@ func gradingBlockSchema() schema.SingleNestedBlock {
 				Optional:    true,
 				Description: "The correct answer. Must match an option value for multiple choice.",
 			},
+			"correct_answers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("correct_answer")),
+				},
+				Description: "All correct answers. Use for checkbox questions with several correct options, or text questions that accept several answers. Every value must match an option for choice questions. Conflicts with correct_answer.",
+			},
 			"feedback_correct": schema.StringAttribute{
 				Optional:    true,
 				Description: "Feedback shown when the answer is correct.",
@@ -698,6 +905,44 @@ func gradingBlockSchema() schema.SingleNestedBlock {
 				Optional:    true,
 				Description: "Feedback shown when the answer is incorrect.",
 			},
+			"general_feedback": schema.StringAttribute{
+				Optional:    true,
+				Description: "Feedback shown for the question regardless of the answer (used for questions graded manually, such as paragraphs).",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"feedback_link": schema.ListNestedBlock{
+				Description: "Links attached to quiz feedback.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"applies_to": appliesTo,
+						"uri": schema.StringAttribute{
+							Required:    true,
+							Description: "The link URI.",
+						},
+						"display_text": schema.StringAttribute{
+							Required:    true,
+							Description: "The text shown for the link.",
+						},
+					},
+				},
+			},
+			"feedback_video": schema.ListNestedBlock{
+				Description: "YouTube videos attached to quiz feedback.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"applies_to": appliesTo,
+						"youtube_uri": schema.StringAttribute{
+							Required:    true,
+							Description: "The YouTube video URI.",
+						},
+						"display_text": schema.StringAttribute{
+							Required:    true,
+							Description: "The title shown for the video.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,339 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// Section blocks are a configuration layout only. The CRUD code works on the
+// flat item list the Forms API uses: getFormModel flattens each section into a
+// section_header item keyed by section_key followed by the section's items,
+// and setFormState groups the flat list back into sections before it is
+// saved. A section's next_section_key is applied as the navigation of the
+// options of its multiple_choice and dropdown questions that have none,
+// because the Forms API cannot set the destination of a page break.
+
+// sectionAttrTypes returns the attribute types of a section block.
+func sectionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"section_key":      types.StringType,
+		"title":            types.StringType,
+		"description":      types.StringType,
+		"next_section_key": types.StringType,
+		"google_item_id":   types.StringType,
+		"item":             types.ListType{ElemType: itemObjectType()},
+	}
+}
+
+// sectionObjectType returns the object type of a section block.
+func sectionObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: sectionAttrTypes()}
+}
+
+// modelGetter is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config.
+type modelGetter interface {
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+}
+
+// getFormModel reads a plan or state into m and flattens its sections into
+// m.Items.
+func getFormModel(ctx context.Context, src modelGetter, m *FormResourceModel) diag.Diagnostics {
+	diags := src.Get(ctx, m)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(flattenSections(ctx, m)...)
+	return diags
+}
+
+// setFormState renders navigation_graph from the flat m.Items, groups them
+// back into sections and saves m.
+func setFormState(ctx context.Context, st *tfsdk.State, m FormResourceModel) diag.Diagnostics {
+	graph, diags := navigationGraphValue(ctx, m.Items)
+	m.NavigationGraph = graph
+	diags.Append(regroupSections(ctx, &m)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(st.Set(ctx, &m)...)
+	return diags
+}
+
+// usesSections reports whether m has section blocks.
+func usesSections(m FormResourceModel) bool {
+	return !m.Sections.IsNull() && !m.Sections.IsUnknown() && len(m.Sections.Elements()) > 0
+}
+
+// flattenSections appends the items of m's sections to m.Items, each section
+// led by a section_header item. m.Sections is kept for regroupSections.
+func flattenSections(ctx context.Context, m *FormResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !usesSections(*m) {
+		return diags
+	}
+
+	flat, ok, d := flatSectionItems(ctx, m.Items, m.Sections, true)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if !ok {
+		m.Items = types.ListUnknown(itemObjectType())
+		return diags
+	}
+
+	list, d := types.ListValueFrom(ctx, itemObjectType(), flat)
+	diags.Append(d...)
+	m.Items = list
+	return diags
+}
+
+// flatSectionItems returns the top-level items followed by the items of each
+// section, with next_section_key applied when derive is set. ok is false when
+// an item list is unknown.
+func flatSectionItems(ctx context.Context, items, sections types.List, derive bool) ([]ItemModel, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if items.IsUnknown() {
+		return nil, false, diags
+	}
+
+	var flat []ItemModel
+	if !items.IsNull() {
+		diags.Append(items.ElementsAs(ctx, &flat, false)...)
+	}
+	if sections.IsUnknown() {
+		return nil, false, diags
+	}
+	var sectionModels []SectionModel
+	if !sections.IsNull() {
+		diags.Append(sections.ElementsAs(ctx, &sectionModels, false)...)
+	}
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	for _, s := range sectionModels {
+		if s.Items.IsUnknown() {
+			return nil, false, diags
+		}
+		flat = append(flat, ItemModel{
+			ItemKey:      s.SectionKey,
+			GoogleItemID: s.GoogleItemID,
+			SectionHeader: &SectionHeaderModel{
+				Title:       s.Title,
+				Description: s.Description,
+			},
+		})
+		var sectionItems []ItemModel
+		if !s.Items.IsNull() {
+			diags.Append(s.Items.ElementsAs(ctx, &sectionItems, false)...)
+		}
+		for _, it := range sectionItems {
+			if derive {
+				applyNextSection(ctx, &it, s.NextSectionKey, &diags)
+			}
+			flat = append(flat, it)
+		}
+		if diags.HasError() {
+			return nil, false, diags
+		}
+	}
+	return flat, true, diags
+}
+
+// configItems returns the items of a configuration the way the CRUD code sees
+// them, with sections flattened but next_section_key not applied. ok is false
+// when the items are not known yet.
+func configItems(ctx context.Context, cfg tfsdk.Config) ([]ItemModel, bool, diag.Diagnostics) {
+	var items, sections types.List
+	diags := cfg.GetAttribute(ctx, path.Root("item"), &items)
+	diags.Append(cfg.GetAttribute(ctx, path.Root("section"), &sections)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+	return flatSectionItems(ctx, items, sections, false)
+}
+
+// applyNextSection makes the options of a multiple_choice or dropdown item
+// that have no navigation go to the section next.
+func applyNextSection(ctx context.Context, it *ItemModel, next types.String, diags *diag.Diagnostics) {
+	if next.IsNull() || next.IsUnknown() || next.ValueString() == "" {
+		return
+	}
+	var options, optionBlocks *types.List
+	switch {
+	case it.MultipleChoice != nil:
+		mc := *it.MultipleChoice
+		it.MultipleChoice = &mc
+		options, optionBlocks = &mc.Options, &mc.Option
+	case it.Dropdown != nil:
+		dd := *it.Dropdown
+		it.Dropdown = &dd
+		options, optionBlocks = &dd.Options, &dd.Option
+	default:
+		return
+	}
+
+	opts, d := tfChoiceOptionsToConvert(ctx, *options, *optionBlocks)
+	diags.Append(d...)
+	for i := range opts {
+		if opts[i].GoToAction == "" && opts[i].GoToSectionKey == "" && opts[i].GoToSectionID == "" {
+			opts[i].GoToSectionKey = next.ValueString()
+		}
+	}
+	*options, *optionBlocks, d = convertChoiceOptionsToTF(ctx, opts)
+	diags.Append(d...)
+}
+
+// regroupSections splits the flat m.Items of a form with section blocks into
+// the items before the first section_header and one section per
+// section_header, removing the navigation added by applyNextSection.
+func regroupSections(ctx context.Context, m *FormResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !usesSections(*m) {
+		return diags
+	}
+	if m.Items.IsNull() || m.Items.IsUnknown() {
+		m.Sections = types.ListNull(sectionObjectType())
+		return diags
+	}
+
+	var configured []SectionModel
+	diags.Append(m.Sections.ElementsAs(ctx, &configured, false)...)
+	var flat []ItemModel
+	diags.Append(m.Items.ElementsAs(ctx, &flat, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	nextByKey := make(map[string]types.String, len(configured))
+	for _, s := range configured {
+		nextByKey[s.SectionKey.ValueString()] = s.NextSectionKey
+	}
+	derived := derivedNavigation(ctx, configured, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	top := []ItemModel{}
+	var sections []SectionModel
+	var sectionItems [][]ItemModel
+	for _, it := range flat {
+		if it.SectionHeader != nil {
+			key := it.ItemKey.ValueString()
+			next, ok := nextByKey[key]
+			if !ok {
+				next = types.StringNull()
+			}
+			sections = append(sections, SectionModel{
+				SectionKey:     it.ItemKey,
+				Title:          it.SectionHeader.Title,
+				Description:    it.SectionHeader.Description,
+				NextSectionKey: next,
+				GoogleItemID:   it.GoogleItemID,
+			})
+			sectionItems = append(sectionItems, []ItemModel{})
+			continue
+		}
+		if len(sections) == 0 {
+			top = append(top, it)
+			continue
+		}
+		last := len(sections) - 1
+		removeNextSection(ctx, &it, sections[last].NextSectionKey, derived[it.ItemKey.ValueString()], &diags)
+		sectionItems[last] = append(sectionItems[last], it)
+	}
+
+	for i := range sections {
+		list, d := types.ListValueFrom(ctx, itemObjectType(), sectionItems[i])
+		diags.Append(d...)
+		sections[i].Items = list
+	}
+	items, d := types.ListValueFrom(ctx, itemObjectType(), top)
+	diags.Append(d...)
+	sectionList, d := types.ListValueFrom(ctx, sectionObjectType(), sections)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	m.Items = items
+	m.Sections = sectionList
+	return diags
+}
+
+// removeNextSection is the inverse of applyNextSection: options that go to
+// the section next lose their navigation if applyNextSection gave it to them,
+// that is if their value is in derived. Navigation to next that was set in the
+// Forms editor, for example on a new option, is kept so that it shows up as a
+// change.
+func removeNextSection(ctx context.Context, it *ItemModel, next types.String, derived map[string]bool, diags *diag.Diagnostics) {
+	if next.IsNull() || next.IsUnknown() || next.ValueString() == "" || len(derived) == 0 {
+		return
+	}
+	options, optionBlocks := choiceOptionLists(it)
+	if options == nil {
+		return
+	}
+
+	opts, d := tfChoiceOptionsToConvert(ctx, *options, *optionBlocks)
+	diags.Append(d...)
+	for i := range opts {
+		if derived[opts[i].Value] && opts[i].GoToAction == "" && opts[i].GoToSectionKey == next.ValueString() {
+			opts[i] = convert.ChoiceOption{Value: opts[i].Value}
+		}
+	}
+	*options, *optionBlocks, d = convertChoiceOptionsToTF(ctx, opts)
+	diags.Append(d...)
+}
+
+// derivedNavigation returns, by item_key, the option values of the choice
+// questions of sections with a next_section_key that have no navigation in
+// the configured sections: the options applyNextSection gives navigation to.
+func derivedNavigation(ctx context.Context, sections []SectionModel, diags *diag.Diagnostics) map[string]map[string]bool {
+	out := map[string]map[string]bool{}
+	for _, s := range sections {
+		next := s.NextSectionKey
+		if next.IsNull() || next.IsUnknown() || next.ValueString() == "" || s.Items.IsNull() || s.Items.IsUnknown() {
+			continue
+		}
+		var items []ItemModel
+		diags.Append(s.Items.ElementsAs(ctx, &items, false)...)
+		for i := range items {
+			options, optionBlocks := choiceOptionLists(&items[i])
+			if options == nil {
+				continue
+			}
+			opts, d := tfChoiceOptionsToConvert(ctx, *options, *optionBlocks)
+			diags.Append(d...)
+			values := map[string]bool{}
+			for _, o := range opts {
+				if o.GoToAction == "" && o.GoToSectionKey == "" && o.GoToSectionID == "" {
+					values[o.Value] = true
+				}
+			}
+			out[items[i].ItemKey.ValueString()] = values
+		}
+	}
+	return out
+}
+
+// choiceOptionLists returns the options and option blocks of a multiple_choice
+// or dropdown item, or nils for other items.
+func choiceOptionLists(it *ItemModel) (options, optionBlocks *types.List) {
+	switch {
+	case it.MultipleChoice != nil:
+		return &it.MultipleChoice.Options, &it.MultipleChoice.Option
+	case it.Dropdown != nil:
+		return &it.Dropdown.Options, &it.Dropdown.Option
+	}
+	return nil, nil
+}
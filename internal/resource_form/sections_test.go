@@ -0,0 +1,344 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+// sectionVal builds a section block; an empty next leaves next_section_key
+// null.
+func sectionVal(t *testing.T, key, title, next string, items ...tftypes.Value) tftypes.Value {
+	t.Helper()
+	sType := sectionBlockType(t)
+	nextVal := tftypes.NewValue(tftypes.String, nil)
+	if next != "" {
+		nextVal = tftypes.NewValue(tftypes.String, next)
+	}
+	return newObjectValue(sType, map[string]tftypes.Value{
+		"section_key":      tftypes.NewValue(tftypes.String, key),
+		"title":            tftypes.NewValue(tftypes.String, title),
+		"next_section_key": nextVal,
+		"item":             itemListVal(t, items...),
+	})
+}
+
+func sectionBlockType(t *testing.T) tftypes.Object {
+	t.Helper()
+	objType, ok := testSchemaResp().Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected an object schema type")
+	}
+	return objType.AttributeTypes["section"].(tftypes.List).ElementType.(tftypes.Object)
+}
+
+func sectionListVal(t *testing.T, sections ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(tftypes.List{ElementType: sectionBlockType(t)}, sections)
+}
+
+// sectionedForm is the live form of sectionedPlanVals: a first-section
+// question, then two sections; the "Color?" options go to "Part 2".
+func sectionedForm(id string) *forms.Form {
+	text := func(itemID, title string) *forms.Item {
+		return &forms.Item{ItemId: itemID, Title: title, QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{TextQuestion: &forms.TextQuestion{}},
+		}}
+	}
+	return &forms.Form{
+		FormId:       id,
+		Info:         &forms.Info{Title: "Sectioned", DocumentTitle: "Sectioned"},
+		ResponderUri: "https://docs.google.com/forms/d/" + id + "/viewform",
+		Items: []*forms.Item{
+			text("gid_name", "Name?"),
+			{ItemId: "gid_s1", Title: "Part 1", PageBreakItem: &forms.PageBreakItem{}},
+			{ItemId: "gid_color", Title: "Color?", QuestionItem: &forms.QuestionItem{
+				Question: &forms.Question{ChoiceQuestion: &forms.ChoiceQuestion{
+					Type: "RADIO",
+					Options: []*forms.Option{
+						{Value: "Red", GoToSectionId: "gid_s2"},
+						{Value: "Blue", GoToSectionId: "gid_s2"},
+					},
+				}},
+			}},
+			{ItemId: "gid_s2", Title: "Part 2", PageBreakItem: &forms.PageBreakItem{}},
+			text("gid_why", "Why?"),
+		},
+	}
+}
+
+func sectionedPlanVals(t *testing.T) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Sectioned"),
+		"published":           tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses": tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                tftypes.NewValue(tftypes.Bool, false),
+		"item":                itemListVal(t, saItem(t, "name", "Name?", nil)),
+		"section": sectionListVal(t,
+			sectionVal(t, "part_1", "Part 1", "part_2",
+				mcItem(t, "color", "Color?", []string{"Red", "Blue"}, nil),
+			),
+			sectionVal(t, "part_2", "Part 2", "",
+				saItem(t, "why", "Why?", nil),
+			),
+		),
+	}
+}
+
+func TestGetFormModel_FlattensSectionsWithNextSection(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var m FormResourceModel
+	diags := getFormModel(ctx, buildPlan(t, sectionedPlanVals(t)), &m)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	items, d := tfItemsToConvertItems(ctx, m.Items)
+	if d.HasError() {
+		t.Fatalf("unexpected errors: %v", d)
+	}
+	var keys []string
+	for _, it := range items {
+		keys = append(keys, it.ItemKey)
+	}
+	if want := "name part_1 color part_2 why"; strings.Join(keys, " ") != want {
+		t.Fatalf("flattened keys = %q, want %q", strings.Join(keys, " "), want)
+	}
+	if items[1].SectionHeader == nil || items[1].SectionHeader.Title != "Part 1" {
+		t.Errorf("expected part_1 to be a section header, got %+v", items[1])
+	}
+	for _, o := range items[2].MultipleChoice.Options {
+		if o.GoToSectionKey != "part_2" {
+			t.Errorf("option %q go_to_section_key = %q, want part_2", o.Value, o.GoToSectionKey)
+		}
+	}
+}
+
+func TestRegroupSections_KeepsNavigationNotDerived(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var m FormResourceModel
+	diags := getFormModel(ctx, buildPlan(t, sectionedPlanVals(t)), &m)
+	items, d := tfItemsToConvertItems(ctx, m.Items)
+	diags.Append(d...)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	// An option added in the Forms editor that also goes to part_2.
+	color := items[2].MultipleChoice
+	color.Options = append(color.Options, convert.ChoiceOption{Value: "Green", GoToSectionKey: "part_2"})
+	m.Items, d = convertItemsToTFList(ctx, items)
+	diags.Append(d...)
+	diags.Append(regroupSections(ctx, &m)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	var sections []SectionModel
+	diags.Append(m.Sections.ElementsAs(ctx, &sections, false)...)
+	sectionItems, d := tfItemsToConvertItems(ctx, sections[0].Items)
+	diags.Append(d...)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	var got []string
+	for _, o := range sectionItems[0].MultipleChoice.Options {
+		got = append(got, o.Value+"->"+o.GoToSectionKey)
+	}
+	if want := "Red-> Blue-> Green->part_2"; strings.Join(got, " ") != want {
+		t.Errorf("options = %q, want %q", strings.Join(got, " "), want)
+	}
+}
+
+func TestCreate_WithSections_RoundTrips(t *testing.T) {
+	t.Parallel()
+
+	var navigation []string
+	mockForms := &testutil.MockFormsAPI{
+		CreateFunc: func(_ context.Context, form *forms.Form) (*forms.Form, error) {
+			return &forms.Form{FormId: "sectioned-form", Info: form.Info}, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			ids := []string{"gid_name", "gid_s1", "gid_color", "gid_s2", "gid_why"}
+			out := &forms.BatchUpdateFormResponse{}
+			created := 0
+			for _, r := range req.Requests {
+				reply := &forms.Response{}
+				if r.CreateItem != nil && created < len(ids) {
+					reply.CreateItem = &forms.CreateItemResponse{ItemId: ids[created]}
+					created++
+				}
+				if r.UpdateItem != nil && r.UpdateItem.Item.QuestionItem != nil {
+					for _, o := range r.UpdateItem.Item.QuestionItem.Question.ChoiceQuestion.Options {
+						navigation = append(navigation, o.Value+"->"+o.GoToSectionId)
+					}
+				}
+				out.Replies = append(out.Replies, reply)
+			}
+			return out, nil
+		},
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			f := sectionedForm(formID)
+			if len(navigation) == 0 {
+				for _, o := range f.Items[2].QuestionItem.Question.ChoiceQuestion.Options {
+					o.GoToSectionId = ""
+				}
+			}
+			return f, nil
+		},
+	}
+	r := testResource(mockForms, &testutil.MockDriveAPI{})
+	ctx := context.Background()
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(ctx, resource.CreateRequest{Plan: buildPlan(t, sectionedPlanVals(t))}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if strings.Join(navigation, " ") != "Red->gid_s2 Blue->gid_s2" {
+		t.Errorf("navigation updates = %v, want both options to go to gid_s2", navigation)
+	}
+
+	var got FormResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	var sections []SectionModel
+	resp.Diagnostics.Append(got.Sections.ElementsAs(ctx, &sections, false)...)
+	var top []ItemModel
+	resp.Diagnostics.Append(got.Items.ElementsAs(ctx, &top, false)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if len(top) != 1 || top[0].ItemKey.ValueString() != "name" {
+		t.Fatalf("top-level items = %+v, want only name", top)
+	}
+	if len(sections) != 2 || sections[0].GoogleItemID.ValueString() != "gid_s1" || sections[1].SectionKey.ValueString() != "part_2" {
+		t.Fatalf("unexpected sections: %+v", sections)
+	}
+	if sections[0].NextSectionKey.ValueString() != "part_2" {
+		t.Errorf("next_section_key = %s, want part_2", sections[0].NextSectionKey)
+	}
+	if edge := `"part_1" -> "part_2" [label="color: Red, Blue"]`; !strings.Contains(got.NavigationGraph.ValueString(), edge) {
+		t.Errorf("navigation_graph = %s, want it to contain %s", got.NavigationGraph.ValueString(), edge)
+	}
+	var colorItems []ItemModel
+	resp.Diagnostics.Append(sections[0].Items.ElementsAs(ctx, &colorItems, false)...)
+	if len(colorItems) != 1 || colorItems[0].MultipleChoice == nil {
+		t.Fatalf("unexpected part_1 items: %+v", colorItems)
+	}
+	// The navigation derived from next_section_key is not shown as option
+	// blocks, so the state matches the configuration.
+	if !colorItems[0].MultipleChoice.Option.IsNull() || len(colorItems[0].MultipleChoice.Options.Elements()) != 2 {
+		t.Errorf("expected plain options without navigation, got options %s, option %s",
+			colorItems[0].MultipleChoice.Options, colorItems[0].MultipleChoice.Option)
+	}
+}
+
+func TestSectionValidation(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		vals map[string]tftypes.Value
+		want string
+	}{
+		{
+			name: "next section without branching question",
+			vals: map[string]tftypes.Value{"section": sectionListVal(t,
+				sectionVal(t, "part_1", "Part 1", "part_2", saItem(t, "why", "Why?", nil)),
+				sectionVal(t, "part_2", "Part 2", ""),
+			)},
+			want: "has no multiple_choice or dropdown question",
+		},
+		{
+			name: "next section with several branching questions",
+			vals: map[string]tftypes.Value{"section": sectionListVal(t,
+				sectionVal(t, "part_1", "Part 1", "part_2",
+					mcItem(t, "color", "Color?", []string{"Red"}, nil),
+					mcItem(t, "size", "Size?", []string{"S"}, nil),
+				),
+				sectionVal(t, "part_2", "Part 2", ""),
+			)},
+			want: "has 2 multiple_choice or dropdown questions",
+		},
+		{
+			name: "unknown next section",
+			vals: map[string]tftypes.Value{"section": sectionListVal(t,
+				sectionVal(t, "part_1", "Part 1", "nope", mcItem(t, "color", "Color?", []string{"Red"}, nil)),
+			)},
+			want: `next_section_key "nope" must reference`,
+		},
+		{
+			name: "section header in top-level items",
+			vals: map[string]tftypes.Value{
+				"item": itemListVal(t, newItemVal(itemBlockType(t), "intro", map[string]tftypes.Value{
+					"section_header": newObjectValue(itemBlockType(t).AttributeTypes["section_header"].(tftypes.Object), map[string]tftypes.Value{
+						"title": tftypes.NewValue(tftypes.String, "Intro"),
+					}),
+				})),
+				"section": sectionListVal(t, sectionVal(t, "part_1", "Part 1", "")),
+			},
+			want: "declare sections as section blocks",
+		},
+		{
+			name: "duplicate key across sections and items",
+			vals: map[string]tftypes.Value{
+				"item":    itemListVal(t, saItem(t, "part_1", "Name?", nil)),
+				"section": sectionListVal(t, sectionVal(t, "part_1", "Part 1", "")),
+			},
+			want: "Duplicate item_key",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			diags := runValidators(t, buildConfig(t, tc.vals),
+				MutuallyExclusiveValidator{}, UniqueItemKeyValidator{}, ChoiceOptionNavigationValidator{})
+			expectErrorContains(t, diags, tc.want)
+		})
+	}
+}
+
+func TestChoiceOptionNavigationValidator_WarnsAboutNavigationGraph(t *testing.T) {
+	t.Parallel()
+
+	// next_section_key is where part_1 continues; "color" has no navigation
+	// of its own, so it is not a branching question that should be required.
+	diags := runValidators(t, buildConfig(t, sectionedPlanVals(t)), ChoiceOptionNavigationValidator{})
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	vals := sectionedPlanVals(t)
+	vals["section"] = sectionListVal(t,
+		sectionVal(t, "part_1", "Part 1", "part_3",
+			mcItem(t, "color", "Color?", []string{"Red", "Blue"}, nil),
+		),
+		sectionVal(t, "part_2", "Part 2", "", saItem(t, "why", "Why?", nil)),
+		sectionVal(t, "part_3", "Part 3", ""),
+	)
+	diags = runValidators(t, buildConfig(t, vals), ChoiceOptionNavigationValidator{})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	var summaries []string
+	for _, d := range diags.Warnings() {
+		summaries = append(summaries, d.Summary())
+		if !strings.Contains(d.Detail(), "part_2") {
+			t.Errorf("warning %q does not name part_2: %s", d.Summary(), d.Detail())
+		}
+	}
+	if strings.Join(summaries, ",") != "Unreachable Sections" {
+		t.Errorf("warnings = %v, want only Unreachable Sections", summaries)
+	}
+}
@@ -51,7 +51,7 @@ func tfItemsToConvertItems(ctx context.Context, items types.List) ([]convert.Ite
 			mc.Options = opts
 
 			if tf.MultipleChoice.Grading != nil {
-				mc.Grading = tfGradingToConvert(tf.MultipleChoice.Grading)
+				mc.Grading = tfGradingToConvert(ctx, tf.MultipleChoice.Grading, &diags)
 			}
 
 			result[i].MultipleChoice = mc
@@ -64,7 +64,7 @@ func tfItemsToConvertItems(ctx context.Context, items types.List) ([]convert.Ite
 				Required:     tf.ShortAnswer.Required.ValueBool(),
 			}
 			if tf.ShortAnswer.Grading != nil {
-				sa.Grading = tfGradingToConvert(tf.ShortAnswer.Grading)
+				sa.Grading = tfGradingToConvert(ctx, tf.ShortAnswer.Grading, &diags)
 			}
 			result[i].ShortAnswer = sa
 			result[i].Title = sa.QuestionText
@@ -76,7 +76,7 @@ func tfItemsToConvertItems(ctx context.Context, items types.List) ([]convert.Ite
 				Required:     tf.Paragraph.Required.ValueBool(),
 			}
 			if tf.Paragraph.Grading != nil {
-				p.Grading = tfGradingToConvert(tf.Paragraph.Grading)
+				p.Grading = tfGradingToConvert(ctx, tf.Paragraph.Grading, &diags)
 			}
 			result[i].Paragraph = p
 			result[i].Title = p.QuestionText
@@ -95,7 +95,7 @@ func tfItemsToConvertItems(ctx context.Context, items types.List) ([]convert.Ite
 			}
 			dd.Options = opts
 			if tf.Dropdown.Grading != nil {
-				dd.Grading = tfGradingToConvert(tf.Dropdown.Grading)
+				dd.Grading = tfGradingToConvert(ctx, tf.Dropdown.Grading, &diags)
 			}
 			result[i].Dropdown = dd
 			result[i].Title = dd.QuestionText
@@ -115,7 +115,7 @@ func tfItemsToConvertItems(ctx context.Context, items types.List) ([]convert.Ite
 			}
 			cb.Options = opts
 			if tf.Checkbox.Grading != nil {
-				cb.Grading = tfGradingToConvert(tf.Checkbox.Grading)
+				cb.Grading = tfGradingToConvert(ctx, tf.Checkbox.Grading, &diags)
 			}
 			result[i].Checkbox = cb
 			result[i].Title = cb.QuestionText
@@ -271,6 +271,11 @@ func tfItemsToConvertItems(ctx context.Context, items types.List) ([]convert.Ite
 			}
 			result[i].Title = tf.SectionHeader.Title.ValueString()
 		}
+
+		if tf.RawJSON != nil {
+			result[i].RawJSON = &convert.RawJSONBlock{JSON: tf.RawJSON.JSON.ValueString()}
+			result[i].Title = convert.RawItemTitle(tf.RawJSON.JSON.ValueString())
+		}
 	}
 
 	return result, diags
@@ -373,13 +378,44 @@ func choiceOptionAttrTypes() map[string]attr.Type {
 }
 
 // tfGradingToConvert converts a TF GradingModel to a convert.GradingBlock.
-func tfGradingToConvert(g *GradingModel) *convert.GradingBlock {
-	return &convert.GradingBlock{
+func tfGradingToConvert(ctx context.Context, g *GradingModel, diags *diag.Diagnostics) *convert.GradingBlock {
+	gb := &convert.GradingBlock{
 		Points:            g.Points.ValueInt64(),
 		CorrectAnswer:     g.CorrectAnswer.ValueString(),
 		FeedbackCorrect:   g.FeedbackCorrect.ValueString(),
 		FeedbackIncorrect: g.FeedbackIncorrect.ValueString(),
+		GeneralFeedback:   g.GeneralFeedback.ValueString(),
+	}
+
+	if !g.CorrectAnswers.IsNull() && !g.CorrectAnswers.IsUnknown() {
+		diags.Append(g.CorrectAnswers.ElementsAs(ctx, &gb.CorrectAnswers, false)...)
+	}
+
+	if !g.FeedbackLink.IsNull() && !g.FeedbackLink.IsUnknown() {
+		var links []FeedbackLinkModel
+		diags.Append(g.FeedbackLink.ElementsAs(ctx, &links, false)...)
+		for _, l := range links {
+			gb.FeedbackLinks = append(gb.FeedbackLinks, convert.FeedbackLink{
+				AppliesTo:   l.AppliesTo.ValueString(),
+				URI:         l.URI.ValueString(),
+				DisplayText: l.DisplayText.ValueString(),
+			})
+		}
+	}
+
+	if !g.FeedbackVideo.IsNull() && !g.FeedbackVideo.IsUnknown() {
+		var videos []FeedbackVideoModel
+		diags.Append(g.FeedbackVideo.ElementsAs(ctx, &videos, false)...)
+		for _, v := range videos {
+			gb.FeedbackVideos = append(gb.FeedbackVideos, convert.FeedbackVideo{
+				AppliesTo:   v.AppliesTo.ValueString(),
+				YoutubeURI:  v.YoutubeURI.ValueString(),
+				DisplayText: v.DisplayText.ValueString(),
+			})
+		}
 	}
+
+	return gb
 }
 
 // convertFormModelToTFState maps a convert.FormModel back into a
@@ -393,10 +429,22 @@ func convertFormModelToTFState(model *convert.FormModel, plan FormResourceModel)
 	if partialNewItemPolicy.IsNull() || partialNewItemPolicy.IsUnknown() || partialNewItemPolicy.ValueString() == "" {
 		partialNewItemPolicy = types.StringValue("append")
 	}
+	unmanagedItemPolicy := plan.UnmanagedItemPolicy
+	if unmanagedItemPolicy.IsNull() || unmanagedItemPolicy.IsUnknown() || unmanagedItemPolicy.ValueString() == "" {
+		unmanagedItemPolicy = types.StringValue("fail")
+	}
 	conflictPolicy := plan.ConflictPolicy
 	if conflictPolicy.IsNull() || conflictPolicy.IsUnknown() || conflictPolicy.ValueString() == "" {
 		conflictPolicy = types.StringValue("overwrite")
 	}
+	responseProtection := plan.ResponseProtection
+	if responseProtection.IsNull() || responseProtection.IsUnknown() || responseProtection.ValueString() == "" {
+		responseProtection = types.StringValue("off")
+	}
+	deletionPolicy := plan.DeletionPolicy
+	if deletionPolicy.IsNull() || deletionPolicy.IsUnknown() || deletionPolicy.ValueString() == "" {
+		deletionPolicy = types.StringValue("trash")
+	}
 	supportsAllDrives := plan.SupportsAllDrives
 	if supportsAllDrives.IsNull() || supportsAllDrives.IsUnknown() {
 		supportsAllDrives = types.BoolValue(false)
@@ -415,18 +463,36 @@ func convertFormModelToTFState(model *convert.FormModel, plan FormResourceModel)
 			}
 			return types.StringValue(model.EmailCollectionType)
 		}(),
-		UpdateStrategy:       plan.UpdateStrategy,
-		DangerousReplaceAll:  plan.DangerousReplaceAll,
-		ManageMode:           manageMode,
-		PartialNewItemPolicy: partialNewItemPolicy,
-		ConflictPolicy:       conflictPolicy,
-		FolderID:             plan.FolderID,
-		SupportsAllDrives:    supportsAllDrives,
-		ParentIDs:            plan.ParentIDs,
-		ContentJSON:          plan.ContentJSON,
-		ResponderURI:         types.StringValue(model.ResponderURI),
-		DocumentTitle:        types.StringValue(model.DocumentTitle),
-		RevisionID:           types.StringValue(model.RevisionID),
+		UpdateStrategy:          plan.UpdateStrategy,
+		DangerousReplaceAll:     plan.DangerousReplaceAll,
+		AllowTypeReplacement:    plan.AllowTypeReplacement,
+		BatchChunkSize:          plan.BatchChunkSize,
+		ManageMode:              manageMode,
+		PartialNewItemPolicy:    partialNewItemPolicy,
+		UnmanagedItemPolicy:     unmanagedItemPolicy,
+		ConflictPolicy:          conflictPolicy,
+		ResponseProtection:      responseProtection,
+		AcknowledgeResponseLoss: plan.AcknowledgeResponseLoss,
+		DeletionPolicy:          deletionPolicy,
+		DeletionProtection:      plan.DeletionProtection,
+		ArchiveOnDestroy:        plan.ArchiveOnDestroy,
+		FolderID:                plan.FolderID,
+		SourceFormID:            plan.SourceFormID,
+		SupportsAllDrives:       supportsAllDrives,
+		ParentIDs:               plan.ParentIDs,
+		Sections:                plan.Sections,
+		ContentJSON:             plan.ContentJSON,
+		ContentJSONItemIDs:      plan.ContentJSONItemIDs,
+		PlannedItemOperations:   plannedItemOperationsForState(plan.PlannedItemOperations),
+		UnmanagedItems:          plan.UnmanagedItems,
+		ResponderURI:            types.StringValue(model.ResponderURI),
+		DocumentTitle:           types.StringValue(model.DocumentTitle),
+		RevisionID:              types.StringValue(model.RevisionID),
+		ContentHash:             plan.ContentHash,
+	}
+
+	if state.ContentJSONItemIDs.IsUnknown() {
+		state.ContentJSONItemIDs = types.ListNull(types.StringType)
 	}
 
 	// edit_uri follows a known pattern
@@ -464,6 +530,35 @@ func buildItemKeyMap(ctx context.Context, items types.List) (map[string]string,
 	return keyMap, diags
 }
 
+// buildFormToModelOptions derives read-back options from configured items:
+// items configured with raw_json are read back as raw JSON.
+func buildFormToModelOptions(ctx context.Context, items types.List) (convert.FormToModelOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var opts convert.FormToModelOptions
+
+	if items.IsNull() || items.IsUnknown() || len(items.Elements()) == 0 {
+		return opts, diags
+	}
+
+	var tfItems []ItemModel
+	diags.Append(items.ElementsAs(ctx, &tfItems, false)...)
+	if diags.HasError() {
+		return opts, diags
+	}
+
+	for _, item := range tfItems {
+		if item.RawJSON == nil {
+			continue
+		}
+		if opts.RawItemKeys == nil {
+			opts.RawItemKeys = map[string]bool{}
+		}
+		opts.RawItemKeys[item.ItemKey.ValueString()] = true
+	}
+
+	return opts, diags
+}
+
 // convertItemsToTFList converts []convert.ItemModel back to types.List
 // for setting in Terraform state.
 func convertItemsToTFList(ctx context.Context, items []convert.ItemModel) (types.List, diag.Diagnostics) {
@@ -507,7 +602,7 @@ func convertItemModelToTF(ctx context.Context, item convert.ItemModel, diags *di
 			HasOther:     types.BoolValue(mc.HasOther),
 		}
 		if mc.Grading != nil {
-			tf.MultipleChoice.Grading = convertGradingToTF(mc.Grading)
+			tf.MultipleChoice.Grading = convertGradingToTF(ctx, mc.Grading, diags)
 		}
 	}
 
@@ -518,7 +613,7 @@ func convertItemModelToTF(ctx context.Context, item convert.ItemModel, diags *di
 			Required:     types.BoolValue(sa.Required),
 		}
 		if sa.Grading != nil {
-			tf.ShortAnswer.Grading = convertGradingToTF(sa.Grading)
+			tf.ShortAnswer.Grading = convertGradingToTF(ctx, sa.Grading, diags)
 		}
 	}
 
@@ -529,7 +624,7 @@ func convertItemModelToTF(ctx context.Context, item convert.ItemModel, diags *di
 			Required:     types.BoolValue(p.Required),
 		}
 		if p.Grading != nil {
-			tf.Paragraph.Grading = convertGradingToTF(p.Grading)
+			tf.Paragraph.Grading = convertGradingToTF(ctx, p.Grading, diags)
 		}
 	}
 
@@ -545,7 +640,7 @@ func convertItemModelToTF(ctx context.Context, item convert.ItemModel, diags *di
 			Shuffle:      types.BoolValue(dd.Shuffle),
 		}
 		if dd.Grading != nil {
-			tf.Dropdown.Grading = convertGradingToTF(dd.Grading)
+			tf.Dropdown.Grading = convertGradingToTF(ctx, dd.Grading, diags)
 		}
 	}
 
@@ -562,7 +657,7 @@ func convertItemModelToTF(ctx context.Context, item convert.ItemModel, diags *di
 			HasOther:     types.BoolValue(cb.HasOther),
 		}
 		if cb.Grading != nil {
-			tf.Checkbox.Grading = convertGradingToTF(cb.Grading)
+			tf.Checkbox.Grading = convertGradingToTF(ctx, cb.Grading, diags)
 		}
 	}
 
@@ -730,31 +825,58 @@ func convertItemModelToTF(ctx context.Context, item convert.ItemModel, diags *di
 		}
 	}
 
+	if item.RawJSON != nil {
+		tf.RawJSON = &RawJSONModel{JSON: types.StringValue(item.RawJSON.JSON)}
+	}
+
 	return tf
 }
 
 // convertGradingToTF converts a convert.GradingBlock to a TF GradingModel.
-func convertGradingToTF(g *convert.GradingBlock) *GradingModel {
+func convertGradingToTF(ctx context.Context, g *convert.GradingBlock, diags *diag.Diagnostics) *GradingModel {
 	gm := &GradingModel{
-		Points: types.Int64Value(g.Points),
+		Points:            types.Int64Value(g.Points),
+		CorrectAnswer:     stringOrNull(g.CorrectAnswer),
+		CorrectAnswers:    types.ListNull(types.StringType),
+		FeedbackCorrect:   stringOrNull(g.FeedbackCorrect),
+		FeedbackIncorrect: stringOrNull(g.FeedbackIncorrect),
+		GeneralFeedback:   stringOrNull(g.GeneralFeedback),
+		FeedbackLink:      types.ListNull(feedbackLinkObjectType()),
+		FeedbackVideo:     types.ListNull(feedbackVideoObjectType()),
 	}
 
-	if g.CorrectAnswer != "" {
-		gm.CorrectAnswer = types.StringValue(g.CorrectAnswer)
-	} else {
-		gm.CorrectAnswer = types.StringNull()
+	if len(g.CorrectAnswers) > 0 {
+		lv, d := types.ListValueFrom(ctx, types.StringType, g.CorrectAnswers)
+		diags.Append(d...)
+		gm.CorrectAnswers = lv
 	}
 
-	if g.FeedbackCorrect != "" {
-		gm.FeedbackCorrect = types.StringValue(g.FeedbackCorrect)
-	} else {
-		gm.FeedbackCorrect = types.StringNull()
+	if len(g.FeedbackLinks) > 0 {
+		links := make([]FeedbackLinkModel, 0, len(g.FeedbackLinks))
+		for _, l := range g.FeedbackLinks {
+			links = append(links, FeedbackLinkModel{
+				AppliesTo:   types.StringValue(l.AppliesTo),
+				URI:         types.StringValue(l.URI),
+				DisplayText: types.StringValue(l.DisplayText),
+			})
+		}
+		lv, d := types.ListValueFrom(ctx, feedbackLinkObjectType(), links)
+		diags.Append(d...)
+		gm.FeedbackLink = lv
 	}
 
-	if g.FeedbackIncorrect != "" {
-		gm.FeedbackIncorrect = types.StringValue(g.FeedbackIncorrect)
-	} else {
-		gm.FeedbackIncorrect = types.StringNull()
+	if len(g.FeedbackVideos) > 0 {
+		videos := make([]FeedbackVideoModel, 0, len(g.FeedbackVideos))
+		for _, v := range g.FeedbackVideos {
+			videos = append(videos, FeedbackVideoModel{
+				AppliesTo:   types.StringValue(v.AppliesTo),
+				YoutubeURI:  types.StringValue(v.YoutubeURI),
+				DisplayText: types.StringValue(v.DisplayText),
+			})
+		}
+		lv, d := types.ListValueFrom(ctx, feedbackVideoObjectType(), videos)
+		diags.Append(d...)
+		gm.FeedbackVideo = lv
 	}
 
 	return gm
@@ -911,6 +1033,11 @@ func itemObjectType() types.ObjectType {
 					"description": types.StringType,
 				},
 			},
+			"raw_json": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"json": types.StringType,
+				},
+			},
 		},
 	}
 }
@@ -921,8 +1048,32 @@ func gradingObjectType() types.ObjectType {
 		AttrTypes: map[string]attr.Type{
 			"points":             types.Int64Type,
 			"correct_answer":     types.StringType,
+			"correct_answers":    types.ListType{ElemType: types.StringType},
 			"feedback_correct":   types.StringType,
 			"feedback_incorrect": types.StringType,
+			"general_feedback":   types.StringType,
+			"feedback_link":      types.ListType{ElemType: feedbackLinkObjectType()},
+			"feedback_video":     types.ListType{ElemType: feedbackVideoObjectType()},
+		},
+	}
+}
+
+func feedbackLinkObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"applies_to":   types.StringType,
+			"uri":          types.StringType,
+			"display_text": types.StringType,
+		},
+	}
+}
+
+func feedbackVideoObjectType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"applies_to":   types.StringType,
+			"youtube_uri":  types.StringType,
+			"display_text": types.StringType,
 		},
 	}
 }
@@ -993,7 +1144,120 @@ func overlayConvertItemInputsFromTF(
 				items[i].Video.Caption = tf.Video.Caption.ValueString()
 			}
 		}
+
+		// Keep the configured raw_json text when it describes the live item.
+		if items[i].RawJSON != nil && tf.RawJSON != nil && !tf.RawJSON.JSON.IsNull() && !tf.RawJSON.JSON.IsUnknown() {
+			if convert.RawItemJSONEquivalent(items[i].RawJSON.JSON, tf.RawJSON.JSON.ValueString()) {
+				items[i].RawJSON.JSON = tf.RawJSON.JSON.ValueString()
+			}
+		}
+
+		if g, tfg := itemGradingPair(items[i], tf); g != nil && tfg != nil {
+			overlayGradingFromTF(ctx, g, tfg, &diags)
+		}
 	}
 
 	return items, diags
 }
+
+// itemGradingPair returns the grading blocks of a read-back item and its
+// configured counterpart when both are the same question type.
+func itemGradingPair(item convert.ItemModel, tf ItemModel) (*convert.GradingBlock, *GradingModel) {
+	switch {
+	case item.MultipleChoice != nil && tf.MultipleChoice != nil:
+		return item.MultipleChoice.Grading, tf.MultipleChoice.Grading
+	case item.ShortAnswer != nil && tf.ShortAnswer != nil:
+		return item.ShortAnswer.Grading, tf.ShortAnswer.Grading
+	case item.Paragraph != nil && tf.Paragraph != nil:
+		return item.Paragraph.Grading, tf.Paragraph.Grading
+	case item.Dropdown != nil && tf.Dropdown != nil:
+		return item.Dropdown.Grading, tf.Dropdown.Grading
+	case item.Checkbox != nil && tf.Checkbox != nil:
+		return item.Checkbox.Grading, tf.Checkbox.Grading
+	}
+	return nil, nil
+}
+
+// overlayGradingFromTF keeps the configured shape of the grading block when it
+// is equivalent to what the API returned: a single answer configured through
+// correct_answers stays in correct_answers, and feedback material keeps its
+// configured order.
+func overlayGradingFromTF(ctx context.Context, g *convert.GradingBlock, tf *GradingModel, diags *diag.Diagnostics) {
+	configured := tfGradingToConvert(ctx, tf, diags)
+
+	if len(configured.CorrectAnswers) > 0 && configured.CorrectAnswer == "" &&
+		g.CorrectAnswer != "" && len(g.CorrectAnswers) == 0 {
+		g.CorrectAnswers = []string{g.CorrectAnswer}
+		g.CorrectAnswer = ""
+	}
+
+	if sameFeedbackLinks(g.FeedbackLinks, configured.FeedbackLinks) {
+		g.FeedbackLinks = configured.FeedbackLinks
+	}
+	if sameFeedbackVideos(g.FeedbackVideos, configured.FeedbackVideos) {
+		g.FeedbackVideos = configured.FeedbackVideos
+	}
+}
+
+// sameFeedbackLinks reports whether a and b hold the same links, ignoring order.
+func sameFeedbackLinks(a, b []convert.FeedbackLink) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[convert.FeedbackLink]int, len(a))
+	for _, l := range a {
+		counts[l]++
+	}
+	for _, l := range b {
+		if counts[l] == 0 {
+			return false
+		}
+		counts[l]--
+	}
+	return true
+}
+
+// sameFeedbackVideos reports whether a and b hold the same videos, ignoring order.
+func sameFeedbackVideos(a, b []convert.FeedbackVideo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[convert.FeedbackVideo]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
+
+// stringOrNull returns a null string for "" and a known value otherwise.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// contentJSONItemIDsFromTF returns the stored content_json item IDs, or nil
+// when none are recorded.
+func contentJSONItemIDsFromTF(ctx context.Context, ids types.List) ([]string, diag.Diagnostics) {
+	if ids.IsNull() || ids.IsUnknown() {
+		return nil, nil
+	}
+	var out []string
+	diags := ids.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+// contentJSONItemIDsToTF converts content_json item IDs to a Terraform list.
+func contentJSONItemIDsToTF(ctx context.Context, ids []string) (types.List, diag.Diagnostics) {
+	if ids == nil {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, ids)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// Unmanaged items are items of the live form that Terraform does not track:
+// items added in the Forms editor, or items of a copied form that no item
+// block adopted. manage_mode = "partial" always leaves them alone. With
+// manage_mode = "all", unmanaged_item_policy decides: "fail" (the default)
+// refuses to apply until the form owner decides, "delete" reads them into the
+// item list so that the plan removes them, and "keep" leaves them in the form.
+
+// unmanagedItemAttrTypes returns the attribute types of an element of
+// unmanaged_items.
+func unmanagedItemAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"item_id": types.StringType,
+		"title":   types.StringType,
+		"type":    types.StringType,
+		"index":   types.Int64Type,
+	}
+}
+
+func unmanagedItemObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: unmanagedItemAttrTypes()}
+}
+
+// unmanagedItemPolicy returns the unmanaged_item_policy of m, defaulting to
+// "fail".
+func unmanagedItemPolicy(m FormResourceModel) string {
+	if m.UnmanagedItemPolicy.IsNull() || m.UnmanagedItemPolicy.IsUnknown() || m.UnmanagedItemPolicy.ValueString() == "" {
+		return "fail"
+	}
+	return m.UnmanagedItemPolicy.ValueString()
+}
+
+// keepsUnmanagedItems reports whether unmanaged items stay out of the item
+// list of m: always in partial mode, and with unmanaged_item_policy "keep" or
+// "fail" in full mode.
+func keepsUnmanagedItems(m FormResourceModel) bool {
+	return m.ManageMode.ValueString() == "partial" || unmanagedItemPolicy(m) != "delete"
+}
+
+// knownItemIDs returns the Google item IDs tracked by the item list and
+// content_json_item_ids of m. It is nil, so that every item of the form is
+// adopted, only when m tracks no items because the form was just imported, or
+// because content_json_item_ids was not recorded (such items are correlated by
+// content instead). Otherwise an empty item list tracks no items, and every
+// item of the form is unmanaged.
+func knownItemIDs(ctx context.Context, m FormResourceModel, imported bool) (map[string]bool, diag.Diagnostics) {
+	keyMap, diags := buildItemKeyMap(ctx, m.Items)
+	ids, d := contentJSONItemIDsFromTF(ctx, m.ContentJSONItemIDs)
+	diags.Append(d...)
+	contentJSONWithoutIDs := !m.ContentJSON.IsNull() && m.ContentJSON.ValueString() != "" && m.ContentJSONItemIDs.IsNull()
+	if keyMap == nil && len(ids) == 0 && (imported || contentJSONWithoutIDs) {
+		return nil, diags
+	}
+	known := make(map[string]bool, len(keyMap)+len(ids))
+	for id := range keyMap {
+		known[id] = true
+	}
+	for _, id := range ids {
+		if id != "" {
+			known[id] = true
+		}
+	}
+	return known, diags
+}
+
+// unmanagedItem is an item of the live form that is not in known.
+type unmanagedItem struct {
+	id       string
+	title    string
+	itemType string
+	index    int
+}
+
+// findUnmanagedItems returns the items of f that are not in known, in form
+// order. A nil known adopts every item.
+func findUnmanagedItems(f *forms.Form, known map[string]bool) []unmanagedItem {
+	if f == nil || known == nil {
+		return nil
+	}
+	var out []unmanagedItem
+	for i, it := range f.Items {
+		if it == nil || it.ItemId == "" || known[it.ItemId] {
+			continue
+		}
+		itemType := "raw_json"
+		if m, err := convert.FormItemToItemModel(it, "", nil); err == nil && m != nil {
+			if t := convert.ItemType(*m); t != "" {
+				itemType = t
+			}
+		}
+		out = append(out, unmanagedItem{id: it.ItemId, title: it.Title, itemType: itemType, index: i})
+	}
+	return out
+}
+
+// unmanagedItemsValue converts unmanaged items to the unmanaged_items
+// attribute.
+func unmanagedItemsValue(items []unmanagedItem) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elems := make([]attr.Value, 0, len(items))
+	for _, it := range items {
+		obj, d := types.ObjectValue(unmanagedItemAttrTypes(), map[string]attr.Value{
+			"item_id": types.StringValue(it.id),
+			"title":   types.StringValue(it.title),
+			"type":    types.StringValue(it.itemType),
+			"index":   types.Int64Value(int64(it.index)),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+	list, d := types.ListValue(unmanagedItemObjectType(), elems)
+	diags.Append(d...)
+	return list, diags
+}
+
+// appliedUnmanagedItems returns unmanaged_items after an apply: the items of
+// the final form that the new state does not track.
+func appliedUnmanagedItems(f *forms.Form, items []convert.ItemModel, contentJSONItemIDs []string) (types.List, diag.Diagnostics) {
+	known := make(map[string]bool, len(items)+len(contentJSONItemIDs))
+	for _, it := range items {
+		known[it.GoogleItemID] = true
+	}
+	for _, id := range contentJSONItemIDs {
+		known[id] = true
+	}
+	return unmanagedItemsValue(findUnmanagedItems(f, known))
+}
+
+// unmanagedItemsError describes the unmanaged items that stop an apply with
+// unmanaged_item_policy = "fail".
+func unmanagedItemsError(items []unmanagedItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+	lines := make([]string, 0, len(items))
+	for _, it := range items {
+		lines = append(lines, fmt.Sprintf("  - %q (%s, item_id=%s, index %d)", it.title, it.itemType, it.id, it.index))
+	}
+	diags.AddError(
+		"Unmanaged Items In Form",
+		fmt.Sprintf("The form has %d item(s) that Terraform does not manage, for example added in the Google Forms editor:\n%s\n\n"+
+			"unmanaged_item_policy is \"fail\". Set it to \"keep\" to leave these items in the form, or to \"delete\" to delete them with this apply.",
+			len(items), strings.Join(lines, "\n")),
+	)
+	return diags
+}
@@ -0,0 +1,261 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+// trackedNameItem is the short answer item gid_1 of formWithItems as tracked
+// in state. gid_2 is left unmanaged.
+func trackedNameItem(t *testing.T) tftypes.Value {
+	iType := itemBlockType(t)
+	saType, ok := iType.AttributeTypes["short_answer"].(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected short_answer to be tftypes.Object, got %T", iType.AttributeTypes["short_answer"])
+	}
+	sa := newObjectValue(saType, map[string]tftypes.Value{
+		"question_text": tftypes.NewValue(tftypes.String, "Name?"),
+		"required":      tftypes.NewValue(tftypes.Bool, false),
+		"grading":       tftypes.NewValue(saType.AttributeTypes["grading"], nil),
+	})
+	return newItemVal(iType, "name", map[string]tftypes.Value{
+		"google_item_id": tftypes.NewValue(tftypes.String, "gid_1"),
+		"short_answer":   sa,
+	})
+}
+
+func unmanagedFormVals(t *testing.T, title, policy, strategy string) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                    tftypes.NewValue(tftypes.String, "unmanaged-id"),
+		"title":                 tftypes.NewValue(tftypes.String, title),
+		"published":             tftypes.NewValue(tftypes.Bool, false),
+		"accepting_responses":   tftypes.NewValue(tftypes.Bool, false),
+		"quiz":                  tftypes.NewValue(tftypes.Bool, false),
+		"manage_mode":           tftypes.NewValue(tftypes.String, "all"),
+		"update_strategy":       tftypes.NewValue(tftypes.String, strategy),
+		"unmanaged_item_policy": tftypes.NewValue(tftypes.String, policy),
+		"item":                  itemListVal(t, trackedNameItem(t)),
+	}
+}
+
+func TestRead_ReportsUnmanagedItems(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		policy    string
+		wantItems int
+	}{
+		{policy: "delete", wantItems: 2},
+		{policy: "keep", wantItems: 1},
+		{policy: "fail", wantItems: 1},
+	} {
+		t.Run(tc.policy, func(t *testing.T) {
+			t.Parallel()
+
+			mockForms := &testutil.MockFormsAPI{
+				GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+					return formWithItems(formID, "Unmanaged Form"), nil
+				},
+			}
+			r := testResource(mockForms, &testutil.MockDriveAPI{})
+			ctx := context.Background()
+
+			state := buildState(t, unmanagedFormVals(t, "Unmanaged Form", tc.policy, "targeted"))
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+
+			var model FormResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+			var items []ItemModel
+			resp.Diagnostics.Append(model.Items.ElementsAs(ctx, &items, false)...)
+			var unmanaged []struct {
+				ItemID string `tfsdk:"item_id"`
+				Title  string `tfsdk:"title"`
+				Type   string `tfsdk:"type"`
+				Index  int64  `tfsdk:"index"`
+			}
+			resp.Diagnostics.Append(model.UnmanagedItems.ElementsAs(ctx, &unmanaged, false)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("failed to read state: %v", resp.Diagnostics.Errors())
+			}
+
+			if len(items) != tc.wantItems {
+				t.Errorf("expected %d items in state, got %d", tc.wantItems, len(items))
+			}
+			if len(unmanaged) != 1 {
+				t.Fatalf("expected 1 unmanaged item, got %+v", unmanaged)
+			}
+			got := unmanaged[0]
+			if got.ItemID != "gid_2" || got.Title != "Color?" || got.Type != "multiple_choice" || got.Index != 1 {
+				t.Errorf("unmanaged item = %+v, want gid_2 \"Color?\" multiple_choice at index 1", got)
+			}
+		})
+	}
+}
+
+func TestUpdate_UnmanagedItemPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		policy      string
+		strategy    string
+		wantError   string
+		wantDeletes int
+	}{
+		{name: "switch to delete", policy: "delete", strategy: "targeted", wantDeletes: 1},
+		{name: "keep", policy: "keep", strategy: "targeted"},
+		{name: "keep with replace_all", policy: "keep", strategy: "replace_all", wantError: "cannot be used with update_strategy"},
+		{name: "fail", policy: "fail", strategy: "targeted", wantError: "Unmanaged Items In Form"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var batchCalls, deletes int
+			mockForms := &testutil.MockFormsAPI{
+				GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+					return formWithItems(formID, "Unmanaged Form"), nil
+				},
+				BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+					batchCalls++
+					for _, r := range req.Requests {
+						if r.DeleteItem != nil {
+							deletes++
+						}
+					}
+					return &forms.BatchUpdateFormResponse{Replies: make([]*forms.Response, len(req.Requests))}, nil
+				},
+			}
+			r := testResource(mockForms, &testutil.MockDriveAPI{})
+			ctx := context.Background()
+
+			// Switching from "keep" to "delete" deletes the items that
+			// "keep" left out of state.
+			stateVals := unmanagedFormVals(t, "Unmanaged Form", tc.policy, tc.strategy)
+			if tc.policy == "delete" {
+				stateVals["unmanaged_item_policy"] = tftypes.NewValue(tftypes.String, "keep")
+			}
+			state := buildState(t, stateVals)
+			plan := buildPlan(t, unmanagedFormVals(t, "Renamed Form", tc.policy, tc.strategy))
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			if tc.wantError != "" {
+				expectErrorContains(t, resp.Diagnostics, tc.wantError)
+				if batchCalls != 0 {
+					t.Errorf("expected no batchUpdate calls, got %d", batchCalls)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			if deletes != tc.wantDeletes {
+				t.Errorf("deleted %d items, want %d", deletes, tc.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestUnmanagedItems_EmptyItemList(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []string{"keep", "fail"} {
+		t.Run(policy, func(t *testing.T) {
+			t.Parallel()
+
+			var batchCalls, deletes int
+			mockForms := &testutil.MockFormsAPI{
+				GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+					return formWithItems(formID, "Unmanaged Form"), nil
+				},
+				BatchUpdateFunc: func(_ context.Context, _ string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+					batchCalls++
+					for _, r := range req.Requests {
+						if r.DeleteItem != nil {
+							deletes++
+						}
+					}
+					return &forms.BatchUpdateFormResponse{Replies: make([]*forms.Response, len(req.Requests))}, nil
+				},
+			}
+			r := testResource(mockForms, &testutil.MockDriveAPI{})
+			ctx := context.Background()
+
+			// A configuration without items tracks none of the form's items;
+			// they are unmanaged rather than adopted.
+			vals := unmanagedFormVals(t, "Unmanaged Form", policy, "targeted")
+			vals["item"] = itemListVal(t)
+			state := buildState(t, vals)
+			readResp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", readResp.Diagnostics.Errors())
+			}
+			var model FormResourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &model)...)
+			if len(model.Items.Elements()) != 0 || len(model.UnmanagedItems.Elements()) != 2 {
+				t.Fatalf("got %d items and %d unmanaged items, want 0 and 2",
+					len(model.Items.Elements()), len(model.UnmanagedItems.Elements()))
+			}
+
+			vals["title"] = tftypes.NewValue(tftypes.String, "Renamed Form")
+			updateResp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: buildPlan(t, vals), State: state}, updateResp)
+			if policy == "fail" {
+				expectErrorContains(t, updateResp.Diagnostics, "Unmanaged Items In Form")
+				if batchCalls != 0 {
+					t.Errorf("expected no batchUpdate calls, got %d", batchCalls)
+				}
+				return
+			}
+			if updateResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", updateResp.Diagnostics.Errors())
+			}
+			if deletes != 0 {
+				t.Errorf("deleted %d items, want none", deletes)
+			}
+		})
+	}
+}
+
+func TestKnownItemIDs_AdoptsOnlyAfterImport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	empty := FormResourceModel{
+		Items:              types.ListNull(itemObjectType()),
+		ContentJSON:        types.StringNull(),
+		ContentJSONItemIDs: types.ListNull(types.StringType),
+	}
+	if known, _ := knownItemIDs(ctx, empty, true); known != nil {
+		t.Errorf("after import: known = %v, want nil", known)
+	}
+	if known, _ := knownItemIDs(ctx, empty, false); known == nil || len(known) != 0 {
+		t.Errorf("without import: known = %v, want an empty set", known)
+	}
+
+	contentJSON := empty
+	contentJSON.ContentJSON = types.StringValue(`[]`)
+	if known, _ := knownItemIDs(ctx, contentJSON, false); known != nil {
+		t.Errorf("content_json without recorded IDs: known = %v, want nil", known)
+	}
+
+	if !justImported(ctx, fakePrivate{privateKeyImported: []byte("true")}) || justImported(ctx, fakePrivate{}) || justImported(ctx, nil) {
+		t.Error("justImported does not reflect the import flag")
+	}
+}
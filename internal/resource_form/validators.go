@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
 )
 
 // Compile-time interface checks.
@@ -22,6 +24,12 @@ var (
 	_ resource.ConfigValidator = CorrectAnswerInOptionsValidator{}
 	_ resource.ConfigValidator = GradingRequiresQuizValidator{}
 	_ resource.ConfigValidator = ChoiceOptionNavigationValidator{}
+	_ resource.ConfigValidator = ItemCountLimitValidator{}
+	_ resource.ConfigValidator = ChoiceCountLimitValidator{}
+	_ resource.ConfigValidator = TextLengthLimitValidator{}
+	_ resource.ConfigValidator = ScaleAndRatingLimitValidator{}
+	_ resource.ConfigValidator = QuizPointsLimitValidator{}
+	_ resource.ConfigValidator = YouTubeURIValidator{}
 )
 
 // ---------------------------------------------------------------------------
@@ -29,11 +37,12 @@ var (
 // ---------------------------------------------------------------------------
 
 // MutuallyExclusiveValidator ensures content_json and item blocks are not
-// both specified on the same resource.
+// both specified on the same resource, and that content_json is not combined
+// with source_form_id.
 type MutuallyExclusiveValidator struct{}
 
 func (v MutuallyExclusiveValidator) Description(_ context.Context) string {
-	return "Validates that content_json and item blocks are not both set."
+	return "Validates that content_json is not set together with item blocks or source_form_id."
 }
 
 func (v MutuallyExclusiveValidator) MarkdownDescription(ctx context.Context) string {
@@ -51,18 +60,54 @@ func (v MutuallyExclusiveValidator) ValidateResource(
 	var items types.List
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
 
+	var sections types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("section"), &sections)...)
+
+	var sourceFormID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_form_id"), &sourceFormID)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// content_json items would be added after the items of the copied form.
+	// Either value may only be known at apply (such as the id of a template
+	// form created in the same run), so unknown values count as set.
+	if !contentJSON.IsNull() && !sourceFormID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Conflicting Configuration",
+			`Cannot use both "content_json" and "source_form_id" in the same form resource. Use item and section blocks to adopt the items of the copied form.`,
+		)
+		return
+	}
+
 	hasContentJSON := !contentJSON.IsNull() && !contentJSON.IsUnknown()
 	hasItems := !items.IsNull() && !items.IsUnknown() && len(items.Elements()) > 0
+	hasSections := !sections.IsNull() && !sections.IsUnknown() && len(sections.Elements()) > 0
 
-	if hasContentJSON && hasItems {
+	if hasContentJSON && (hasItems || hasSections) {
 		resp.Diagnostics.AddError(
 			"Conflicting Configuration",
-			`Cannot use both "content_json" and "item" blocks in the same form resource.`,
+			`Cannot use both "content_json" and "item" or "section" blocks in the same form resource.`,
 		)
+		return
+	}
+
+	// With section blocks, every section_header starts a section block when
+	// the form is read, so top-level items cannot contain page breaks.
+	if hasSections && hasItems {
+		var itemModels []ItemModel
+		resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
+		for _, it := range itemModels {
+			isRawPageBreak := it.RawJSON != nil && !it.RawJSON.JSON.IsUnknown() && convert.IsRawPageBreak(it.RawJSON.JSON.ValueString())
+			if it.SectionHeader != nil || isRawPageBreak {
+				resp.Diagnostics.AddError(
+					"Conflicting Configuration",
+					fmt.Sprintf("Item %q is a section header; with section blocks, declare sections as section blocks instead of section_header items.", it.ItemKey.ValueString()),
+				)
+				return
+			}
+		}
 	}
 }
 
@@ -130,17 +175,9 @@ func (v UniqueItemKeyValidator) ValidateResource(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) {
-	var items types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
-
-	if resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
-		return
-	}
-
-	var itemModels []ItemModel
-	resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
-
-	if resp.Diagnostics.HasError() {
+	itemModels, ok, diags := configItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
 		return
 	}
 
@@ -183,17 +220,9 @@ func (v ExactlyOneSubBlockValidator) ValidateResource(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) {
-	var items types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
-
-	if resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
-		return
-	}
-
-	var itemModels []ItemModel
-	resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
-
-	if resp.Diagnostics.HasError() {
+	itemModels, ok, diags := configItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
 		return
 	}
 
@@ -208,7 +237,7 @@ func (v ExactlyOneSubBlockValidator) ValidateResource(
 				"Invalid Item Configuration",
 				fmt.Sprintf(
 					"Item %s must have exactly one question type "+
-						"(multiple_choice, short_answer, paragraph, dropdown, checkbox, multiple_choice_grid, checkbox_grid, date, date_time, scale, time, rating, file_upload, text_item, image, video, or section_header), but has %d.",
+						"(multiple_choice, short_answer, paragraph, dropdown, checkbox, multiple_choice_grid, checkbox_grid, date, date_time, scale, time, rating, file_upload, text_item, image, video, section_header, or raw_json), but has %d.",
 					identity, count,
 				),
 			)
@@ -271,5 +300,8 @@ func countSubBlocks(item ItemModel) int {
 	if item.SectionHeader != nil {
 		count++
 	}
+	if item.RawJSON != nil {
+		count++
+	}
 	return count
 }
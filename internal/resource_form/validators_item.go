@@ -5,10 +5,14 @@ package resourceform
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
 )
 
 // ---------------------------------------------------------------------------
@@ -32,17 +36,9 @@ func (v OptionsRequiredForChoiceValidator) ValidateResource(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) {
-	var items types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
-
-	if resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
-		return
-	}
-
-	var itemModels []ItemModel
-	resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
-
-	if resp.Diagnostics.HasError() {
+	itemModels, ok, diags := configItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
 		return
 	}
 
@@ -122,12 +118,13 @@ func (v OptionsRequiredForChoiceValidator) ValidateResource(
 // 6. CorrectAnswerInOptionsValidator
 // ---------------------------------------------------------------------------
 
-// CorrectAnswerInOptionsValidator ensures that when a correct_answer is
-// specified for a multiple_choice question, it matches one of the options.
+// CorrectAnswerInOptionsValidator ensures that every correct answer specified
+// for a choice question (multiple_choice, dropdown, checkbox) matches one of
+// its options, and that single-choice questions have at most one answer.
 type CorrectAnswerInOptionsValidator struct{}
 
 func (v CorrectAnswerInOptionsValidator) Description(_ context.Context) string {
-	return "Validates that correct_answer matches an option for multiple_choice."
+	return "Validates that correct answers match the options of choice questions."
 }
 
 func (v CorrectAnswerInOptionsValidator) MarkdownDescription(ctx context.Context) string {
@@ -139,29 +136,27 @@ func (v CorrectAnswerInOptionsValidator) ValidateResource(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) {
-	var items types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
-
-	if resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
-		return
-	}
-
-	var itemModels []ItemModel
-	resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
-
-	if resp.Diagnostics.HasError() {
+	itemModels, ok, diags := configItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
 		return
 	}
 
 	for _, item := range itemModels {
 		if item.MultipleChoice != nil && item.MultipleChoice.Grading != nil {
-			checkCorrectAnswerInChoiceOptions(ctx, item.MultipleChoice.Grading, item.MultipleChoice.Options, item.MultipleChoice.Option, resp)
+			checkCorrectAnswerInChoiceOptions(ctx, "multiple_choice", true, item.MultipleChoice.Grading, item.MultipleChoice.Options, item.MultipleChoice.Option, resp)
 			if resp.Diagnostics.HasError() {
 				return
 			}
 		}
 		if item.Dropdown != nil && item.Dropdown.Grading != nil {
-			checkCorrectAnswerInChoiceOptions(ctx, item.Dropdown.Grading, item.Dropdown.Options, item.Dropdown.Option, resp)
+			checkCorrectAnswerInChoiceOptions(ctx, "dropdown", true, item.Dropdown.Grading, item.Dropdown.Options, item.Dropdown.Option, resp)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		if item.Checkbox != nil && item.Checkbox.Grading != nil {
+			checkCorrectAnswerInChoiceOptions(ctx, "checkbox", false, item.Checkbox.Grading, item.Checkbox.Options, item.Checkbox.Option, resp)
 			if resp.Diagnostics.HasError() {
 				return
 			}
@@ -169,21 +164,54 @@ func (v CorrectAnswerInOptionsValidator) ValidateResource(
 	}
 }
 
-// checkCorrectAnswerInChoiceOptions validates that the correct_answer is in the options list.
+// checkCorrectAnswerInChoiceOptions validates that every configured correct
+// answer (correct_answer and correct_answers) is in the options list.
 func checkCorrectAnswerInChoiceOptions(
 	ctx context.Context,
+	itemType string,
+	singleAnswer bool,
 	grading *GradingModel,
 	optionsList types.List,
 	optionBlocks types.List,
 	resp *resource.ValidateConfigResponse,
 ) {
-	answer := grading.CorrectAnswer
-	if answer.IsNull() || answer.IsUnknown() {
+	type answer struct {
+		attr  string
+		value string
+	}
+	var answers []answer
+	if !grading.CorrectAnswer.IsNull() && !grading.CorrectAnswer.IsUnknown() {
+		answers = append(answers, answer{"correct_answer", grading.CorrectAnswer.ValueString()})
+	}
+	if grading.CorrectAnswers.IsUnknown() {
+		return
+	}
+	if !grading.CorrectAnswers.IsNull() {
+		var listed []types.String
+		resp.Diagnostics.Append(grading.CorrectAnswers.ElementsAs(ctx, &listed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, a := range listed {
+			if a.IsUnknown() {
+				return
+			}
+			answers = append(answers, answer{"correct_answers value", a.ValueString()})
+		}
+	}
+	if len(answers) == 0 {
 		return
 	}
 
-	answerVal := answer.ValueString()
+	if singleAnswer && len(answers) > 1 {
+		resp.Diagnostics.AddError(
+			"Too Many Correct Answers",
+			"A "+itemType+" question accepts exactly one correct answer. Use a checkbox question for several correct options.",
+		)
+		return
+	}
 
+	valid := make(map[string]bool)
 	// Prefer option blocks if present.
 	if !optionBlocks.IsNull() && !optionBlocks.IsUnknown() && len(optionBlocks.Elements()) > 0 {
 		var opts []ChoiceOptionModel
@@ -192,27 +220,37 @@ func checkCorrectAnswerInChoiceOptions(
 			return
 		}
 		for _, opt := range opts {
-			if opt.Value.ValueString() == answerVal {
+			if opt.Value.IsUnknown() {
 				return
 			}
+			valid[opt.Value.ValueString()] = true
 		}
 	} else {
+		if optionsList.IsUnknown() {
+			return
+		}
 		var options []types.String
 		resp.Diagnostics.Append(optionsList.ElementsAs(ctx, &options, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 		for _, opt := range options {
-			if opt.ValueString() == answerVal {
+			if opt.IsUnknown() {
 				return
 			}
+			valid[opt.ValueString()] = true
 		}
 	}
 
-	resp.Diagnostics.AddError(
-		"Invalid Correct Answer",
-		`The correct_answer "`+answerVal+`" is not in the options list.`,
-	)
+	for _, a := range answers {
+		if !valid[a.value] {
+			resp.Diagnostics.AddError(
+				"Invalid Correct Answer",
+				`The `+a.attr+` "`+a.value+`" is not in the options list.`,
+			)
+			return
+		}
+	}
 }
 
 // ---------------------------------------------------------------------------
@@ -262,17 +300,9 @@ func hasAnyGrading(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) bool {
-	var items types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
-
-	if resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
-		return false
-	}
-
-	var itemModels []ItemModel
-	resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
-
-	if resp.Diagnostics.HasError() {
+	itemModels, ok, diags := configItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
 		return false
 	}
 
@@ -309,7 +339,8 @@ func itemHasGrading(item ItemModel) bool {
 // ---------------------------------------------------------------------------
 
 // ChoiceOptionNavigationValidator validates mutual exclusion between options and
-// option blocks, plus basic section-navigation correctness.
+// option blocks, plus basic section-navigation correctness. It warns about
+// problems in the navigation graph between sections.
 type ChoiceOptionNavigationValidator struct{}
 
 func (v ChoiceOptionNavigationValidator) Description(_ context.Context) string {
@@ -325,15 +356,9 @@ func (v ChoiceOptionNavigationValidator) ValidateResource(
 	req resource.ValidateConfigRequest,
 	resp *resource.ValidateConfigResponse,
 ) {
-	var items types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("item"), &items)...)
-	if resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
-		return
-	}
-
-	var itemModels []ItemModel
-	resp.Diagnostics.Append(items.ElementsAs(ctx, &itemModels, false)...)
-	if resp.Diagnostics.HasError() {
+	itemModels, ok, diags := configItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
 		return
 	}
 
@@ -342,6 +367,9 @@ func (v ChoiceOptionNavigationValidator) ValidateResource(
 		if it.SectionHeader != nil {
 			sections[it.ItemKey.ValueString()] = true
 		}
+		if it.RawJSON != nil && !it.RawJSON.JSON.IsUnknown() && convert.IsRawPageBreak(it.RawJSON.JSON.ValueString()) {
+			sections[it.ItemKey.ValueString()] = true
+		}
 	}
 
 	validateChoice := func(itemKey, kind string, opts types.List, optBlocks types.List) {
@@ -388,7 +416,7 @@ func (v ChoiceOptionNavigationValidator) ValidateResource(
 			if hasKey && !sections[b.GoToSectionKey.ValueString()] {
 				resp.Diagnostics.AddError(
 					"Invalid Option Navigation",
-					"go_to_section_key must reference an item_key with a section_header block in the same form configuration.",
+					"go_to_section_key must reference an item_key with a section_header block (or a raw_json page break) in the same form configuration.",
 				)
 				return
 			}
@@ -416,4 +444,135 @@ func (v ChoiceOptionNavigationValidator) ValidateResource(
 			}
 		}
 	}
+
+	validateNextSections(ctx, req, resp, sections)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateNavigationGraph(ctx, req, resp)
+}
+
+// validateNextSections checks the next_section_key of section blocks: it must
+// name a section, and the section needs exactly one multiple_choice or
+// dropdown question to carry the navigation. Options that already go to the
+// next section must not say so, because they are read back without navigation.
+func validateNextSections(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+	sectionKeys map[string]bool,
+) {
+	var sections []SectionModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("section"), &sections)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, s := range sections {
+		next := s.NextSectionKey
+		if next.IsNull() || next.IsUnknown() || s.Items.IsUnknown() {
+			continue
+		}
+		key := s.SectionKey.ValueString()
+		if !sectionKeys[next.ValueString()] {
+			resp.Diagnostics.AddError(
+				"Invalid Section Navigation",
+				fmt.Sprintf("Section %q: next_section_key %q must reference the section_key of a section block.", key, next.ValueString()),
+			)
+			return
+		}
+
+		var items []ItemModel
+		resp.Diagnostics.Append(s.Items.ElementsAs(ctx, &items, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branching := 0
+		for _, it := range items {
+			var optBlocks types.List
+			switch {
+			case it.MultipleChoice != nil:
+				optBlocks = it.MultipleChoice.Option
+			case it.Dropdown != nil:
+				optBlocks = it.Dropdown.Option
+			default:
+				continue
+			}
+			branching++
+			if optBlocks.IsNull() || optBlocks.IsUnknown() {
+				continue
+			}
+			var blocks []ChoiceOptionModel
+			resp.Diagnostics.Append(optBlocks.ElementsAs(ctx, &blocks, false)...)
+			for _, b := range blocks {
+				if b.GoToSectionKey.ValueString() == next.ValueString() {
+					resp.Diagnostics.AddError(
+						"Invalid Section Navigation",
+						fmt.Sprintf("Item %q: option %q goes to %q, which is already the next_section_key of section %q. Remove go_to_section_key from the option.", it.ItemKey.ValueString(), b.Value.ValueString(), next.ValueString(), key),
+					)
+					return
+				}
+			}
+		}
+		switch {
+		case branching == 0:
+			resp.Diagnostics.AddError(
+				"Invalid Section Navigation",
+				fmt.Sprintf("Section %q sets next_section_key but has no multiple_choice or dropdown question. The Forms API cannot set where a section continues; the provider sets it on the options of that question.", key),
+			)
+			return
+		case branching > 1:
+			resp.Diagnostics.AddError(
+				"Invalid Section Navigation",
+				fmt.Sprintf("Section %q sets next_section_key but has %d multiple_choice or dropdown questions. The provider sets next_section_key on the options of the section's only such question, because a section can branch on one answer. Set go_to_section_key on the options of one of the questions instead.", key, branching),
+			)
+			return
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// rawItemJSONValidator (attribute validator for item.raw_json.json)
+// ---------------------------------------------------------------------------
+
+// rawItemJSONValidator ensures raw_json holds a single Forms API Item object
+// with exactly one item kind (question, question group, page break, text,
+// image or video).
+type rawItemJSONValidator struct{}
+
+var _ validator.String = rawItemJSONValidator{}
+
+func (v rawItemJSONValidator) Description(_ context.Context) string {
+	return "Validates that the value is a Forms API Item JSON object."
+}
+
+func (v rawItemJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rawItemJSONValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	item, err := convert.ParseRawItemJSON(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid raw_json",
+			"raw_json must be a Forms API Item JSON object: "+err.Error(),
+		)
+		return
+	}
+	if convert.ItemKind(item) == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid raw_json",
+			"raw_json must set one of questionItem, questionGroupItem, pageBreakItem, textItem, imageItem or videoItem.",
+		)
+	}
 }
@@ -89,6 +89,59 @@ func TestCorrectAnswerInOptions_NoCorrectAnswer_Passes(t *testing.T) {
 	expectNoError(t, diags)
 }
 
+func stringListVal(values ...string) tftypes.Value {
+	vals := make([]tftypes.Value, len(values))
+	for i, v := range values {
+		vals[i] = tftypes.NewValue(tftypes.String, v)
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, vals)
+}
+
+func TestCorrectAnswerInOptions_CheckboxAllAnswersValid_Passes(t *testing.T) {
+	t.Parallel()
+	grading := &map[string]tftypes.Value{
+		"points":          tftypes.NewValue(tftypes.Number, 2),
+		"correct_answers": stringListVal("2", "3"),
+	}
+	cfg := buildConfig(t, map[string]tftypes.Value{
+		"title": tftypes.NewValue(tftypes.String, "T"),
+		"quiz":  tftypes.NewValue(tftypes.Bool, true),
+		"item":  itemListVal(t, cbItem(t, "q1", "Primes?", []string{"2", "3", "4"}, grading)),
+	})
+	diags := runValidators(t, cfg, CorrectAnswerInOptionsValidator{})
+	expectNoError(t, diags)
+}
+
+func TestCorrectAnswerInOptions_CheckboxOneAnswerInvalid_Error(t *testing.T) {
+	t.Parallel()
+	grading := &map[string]tftypes.Value{
+		"points":          tftypes.NewValue(tftypes.Number, 2),
+		"correct_answers": stringListVal("2", "5"),
+	}
+	cfg := buildConfig(t, map[string]tftypes.Value{
+		"title": tftypes.NewValue(tftypes.String, "T"),
+		"quiz":  tftypes.NewValue(tftypes.Bool, true),
+		"item":  itemListVal(t, cbItem(t, "q1", "Primes?", []string{"2", "3", "4"}, grading)),
+	})
+	diags := runValidators(t, cfg, CorrectAnswerInOptionsValidator{})
+	expectErrorContains(t, diags, `correct_answers value "5"`)
+}
+
+func TestCorrectAnswerInOptions_MultipleChoiceSeveralAnswers_Error(t *testing.T) {
+	t.Parallel()
+	grading := &map[string]tftypes.Value{
+		"points":          tftypes.NewValue(tftypes.Number, 2),
+		"correct_answers": stringListVal("A", "B"),
+	}
+	cfg := buildConfig(t, map[string]tftypes.Value{
+		"title": tftypes.NewValue(tftypes.String, "T"),
+		"quiz":  tftypes.NewValue(tftypes.Bool, true),
+		"item":  itemListVal(t, mcItem(t, "q1", "Pick?", []string{"A", "B"}, grading)),
+	})
+	diags := runValidators(t, cfg, CorrectAnswerInOptionsValidator{})
+	expectErrorContains(t, diags, "exactly one correct answer")
+}
+
 // ---------------------------------------------------------------------------
 // 7. GradingRequiresQuizValidator
 // ---------------------------------------------------------------------------
@@ -176,11 +229,11 @@ func TestGradingRequiresQuiz_Paragraph_Error(t *testing.T) {
 // ConfigValidators wiring
 // ---------------------------------------------------------------------------
 
-func TestConfigValidators_ReturnsAllEight(t *testing.T) {
+func TestConfigValidators_ReturnsAllFourteen(t *testing.T) {
 	t.Parallel()
 	r := &FormResource{}
 	validators := r.ConfigValidators(context.Background())
-	if len(validators) != 8 {
-		t.Fatalf("expected 8 ConfigValidators, got %d", len(validators))
+	if len(validators) != 14 {
+		t.Fatalf("expected 14 ConfigValidators, got %d", len(validators))
 	}
 }
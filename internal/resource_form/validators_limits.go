@@ -0,0 +1,425 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// Limits of Google Forms. The Forms API rejects batchUpdate requests that
+// exceed them with 400 Bad Request, so they are checked at plan time.
+const (
+	maxFormItems          = 2000
+	maxChoiceOptions      = 1000
+	maxGridRows           = 100
+	maxGridColumns        = 100
+	maxTitleLength        = 8192
+	maxDescriptionLength  = 32768
+	minScaleHigh          = 2
+	maxScaleHigh          = 10
+	minRatingScaleLevel   = 3
+	maxRatingScaleLevel   = 10
+	maxQuestionPointValue = 1000
+)
+
+// limitItem is an item checked against the Forms limits. label names it in
+// diagnostics: the item_key for item blocks, the index for content_json.
+type limitItem struct {
+	label string
+	item  *forms.Item
+}
+
+// configLimitItems returns the items of a configuration as Forms API items,
+// from content_json or from item and section blocks. ok is false when they
+// are not known yet. Items that cannot be converted are left out; the other
+// validators report them.
+func configLimitItems(ctx context.Context, cfg tfsdk.Config) ([]limitItem, bool, diag.Diagnostics) {
+	var contentJSON types.String
+	diags := cfg.GetAttribute(ctx, path.Root("content_json"), &contentJSON)
+	if diags.HasError() || contentJSON.IsUnknown() {
+		return nil, false, diags
+	}
+	if contentJSON.ValueString() != "" {
+		parsed, err := convert.ParseDeclarativeJSON(contentJSON.ValueString())
+		if err != nil {
+			return nil, false, diags
+		}
+		out := make([]limitItem, 0, len(parsed))
+		for i, it := range parsed {
+			if it != nil {
+				out = append(out, limitItem{label: fmt.Sprintf("content_json[%d]", i), item: it})
+			}
+		}
+		return out, true, diags
+	}
+
+	flat, ok, d := configItems(ctx, cfg)
+	diags.Append(d...)
+	if diags.HasError() || !ok || len(flat) == 0 {
+		return nil, ok, diags
+	}
+	list, d := types.ListValueFrom(ctx, itemObjectType(), flat)
+	diags.Append(d...)
+	converted, d := tfItemsToConvertItems(ctx, list)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+	out := make([]limitItem, 0, len(converted))
+	for i, it := range converted {
+		req, err := convert.ItemModelToCreateRequest(it, i)
+		if err != nil || req.CreateItem == nil || req.CreateItem.Item == nil {
+			continue
+		}
+		out = append(out, limitItem{label: fmt.Sprintf("item %q", it.ItemKey), item: req.CreateItem.Item})
+	}
+	return out, true, diags
+}
+
+// itemQuestions returns the questions of an item: its question, or the row
+// questions of a grid.
+func itemQuestions(it *forms.Item) []*forms.Question {
+	if it.QuestionItem != nil && it.QuestionItem.Question != nil {
+		return []*forms.Question{it.QuestionItem.Question}
+	}
+	if it.QuestionGroupItem != nil {
+		return it.QuestionGroupItem.Questions
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// 9. ItemCountLimitValidator
+// ---------------------------------------------------------------------------
+
+// ItemCountLimitValidator ensures a form has no more items than Google Forms
+// allows. Section page breaks count as items.
+type ItemCountLimitValidator struct{}
+
+func (v ItemCountLimitValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Validates that a form has at most %d items.", maxFormItems)
+}
+
+func (v ItemCountLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ItemCountLimitValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	items, ok, diags := configLimitItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+	if len(items) > maxFormItems {
+		resp.Diagnostics.AddError(
+			"Too Many Items",
+			fmt.Sprintf("The form has %d items, including section page breaks; Google Forms allows at most %d.", len(items), maxFormItems),
+		)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 10. ChoiceCountLimitValidator
+// ---------------------------------------------------------------------------
+
+// ChoiceCountLimitValidator ensures choice questions and grids stay within the
+// Google Forms limits on options, rows and columns.
+type ChoiceCountLimitValidator struct{}
+
+func (v ChoiceCountLimitValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Validates that questions have at most %d options and grids at most %d rows and %d columns.",
+		maxChoiceOptions, maxGridRows, maxGridColumns)
+}
+
+func (v ChoiceCountLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ChoiceCountLimitValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	items, ok, diags := configLimitItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	for _, li := range items {
+		it := li.item
+		if it.QuestionItem != nil && it.QuestionItem.Question != nil && it.QuestionItem.Question.ChoiceQuestion != nil {
+			if n := len(it.QuestionItem.Question.ChoiceQuestion.Options); n > maxChoiceOptions {
+				resp.Diagnostics.AddError(
+					"Too Many Options",
+					fmt.Sprintf("The %s has %d options; Google Forms allows at most %d per question.", li.label, n, maxChoiceOptions),
+				)
+			}
+		}
+		if it.QuestionGroupItem == nil {
+			continue
+		}
+		if n := len(it.QuestionGroupItem.Questions); n > maxGridRows {
+			resp.Diagnostics.AddError(
+				"Too Many Grid Rows",
+				fmt.Sprintf("The %s has %d rows; Google Forms allows at most %d per grid.", li.label, n, maxGridRows),
+			)
+		}
+		if g := it.QuestionGroupItem.Grid; g != nil && g.Columns != nil && len(g.Columns.Options) > maxGridColumns {
+			resp.Diagnostics.AddError(
+				"Too Many Grid Columns",
+				fmt.Sprintf("The %s has %d columns; Google Forms allows at most %d per grid.", li.label, len(g.Columns.Options), maxGridColumns),
+			)
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 11. TextLengthLimitValidator
+// ---------------------------------------------------------------------------
+
+// TextLengthLimitValidator ensures the titles and descriptions of the form and
+// its items are not longer than Google Forms allows.
+type TextLengthLimitValidator struct{}
+
+func (v TextLengthLimitValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Validates that titles have at most %d characters and descriptions at most %d.",
+		maxTitleLength, maxDescriptionLength)
+}
+
+func (v TextLengthLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TextLengthLimitValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	check := func(what, text string, limit int) {
+		if n := utf8.RuneCountInString(text); n > limit {
+			resp.Diagnostics.AddError(
+				"Text Too Long",
+				fmt.Sprintf("The %s has %d characters; Google Forms allows at most %d.", what, n, limit),
+			)
+		}
+	}
+
+	var title, description types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("title"), &title)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("description"), &description)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	check("form title", title.ValueString(), maxTitleLength)
+	check("form description", description.ValueString(), maxDescriptionLength)
+
+	items, ok, diags := configLimitItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+	for _, li := range items {
+		check("title of the "+li.label, li.item.Title, maxTitleLength)
+		check("description of the "+li.label, li.item.Description, maxDescriptionLength)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 12. ScaleAndRatingLimitValidator
+// ---------------------------------------------------------------------------
+
+// ScaleAndRatingLimitValidator ensures scale questions use the bounds Google
+// Forms supports (low 0 or 1, high 2 to 10) and rating questions 3 to 10
+// levels.
+type ScaleAndRatingLimitValidator struct{}
+
+func (v ScaleAndRatingLimitValidator) Description(_ context.Context) string {
+	return "Validates scale bounds and rating scale levels."
+}
+
+func (v ScaleAndRatingLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ScaleAndRatingLimitValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	items, ok, diags := configLimitItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	for _, li := range items {
+		for _, q := range itemQuestions(li.item) {
+			if q == nil {
+				continue
+			}
+			// Zero values are unknown or unset and are left to the API.
+			if s := q.ScaleQuestion; s != nil && s.High != 0 {
+				if s.Low != 0 && s.Low != 1 {
+					resp.Diagnostics.AddError(
+						"Invalid Scale",
+						fmt.Sprintf("The %s has low = %d; Google Forms scales start at 0 or 1.", li.label, s.Low),
+					)
+				}
+				if s.High < minScaleHigh || s.High > maxScaleHigh {
+					resp.Diagnostics.AddError(
+						"Invalid Scale",
+						fmt.Sprintf("The %s has high = %d; Google Forms scales end between %d and %d.", li.label, s.High, minScaleHigh, maxScaleHigh),
+					)
+				}
+			}
+			if r := q.RatingQuestion; r != nil && r.RatingScaleLevel != 0 &&
+				(r.RatingScaleLevel < minRatingScaleLevel || r.RatingScaleLevel > maxRatingScaleLevel) {
+				resp.Diagnostics.AddError(
+					"Invalid Rating Scale",
+					fmt.Sprintf("The %s has rating_scale_level = %d; Google Forms ratings have %d to %d levels.",
+						li.label, r.RatingScaleLevel, minRatingScaleLevel, maxRatingScaleLevel),
+				)
+			}
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 13. QuizPointsLimitValidator
+// ---------------------------------------------------------------------------
+
+// QuizPointsLimitValidator ensures the point value of graded questions is in
+// the range Google Forms accepts.
+type QuizPointsLimitValidator struct{}
+
+func (v QuizPointsLimitValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Validates that quiz points are between 0 and %d.", maxQuestionPointValue)
+}
+
+func (v QuizPointsLimitValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v QuizPointsLimitValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	items, ok, diags := configLimitItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	for _, li := range items {
+		for _, q := range itemQuestions(li.item) {
+			if q == nil || q.Grading == nil {
+				continue
+			}
+			if p := q.Grading.PointValue; p < 0 || p > maxQuestionPointValue {
+				resp.Diagnostics.AddError(
+					"Invalid Quiz Points",
+					fmt.Sprintf("The %s is worth %d points; Google Forms accepts 0 to %d.", li.label, p, maxQuestionPointValue),
+				)
+			}
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 14. YouTubeURIValidator
+// ---------------------------------------------------------------------------
+
+// YouTubeURIValidator ensures the URIs of video items and of videos in quiz
+// feedback are YouTube video links; the Forms API embeds nothing else.
+type YouTubeURIValidator struct{}
+
+func (v YouTubeURIValidator) Description(_ context.Context) string {
+	return "Validates that video URIs are YouTube video links."
+}
+
+func (v YouTubeURIValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v YouTubeURIValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	items, ok, diags := configLimitItems(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	check := func(label, uri string) {
+		if uri != "" && !isYouTubeVideoURI(uri) {
+			resp.Diagnostics.AddError(
+				"Invalid YouTube URI",
+				fmt.Sprintf("The %s has youtube_uri %q, which is not a YouTube video link such as https://www.youtube.com/watch?v=VIDEO_ID or https://youtu.be/VIDEO_ID.", label, uri),
+			)
+		}
+	}
+	for _, li := range items {
+		if li.item.VideoItem != nil && li.item.VideoItem.Video != nil {
+			check(li.label, li.item.VideoItem.Video.YoutubeUri)
+		}
+		for _, q := range itemQuestions(li.item) {
+			if q == nil || q.Grading == nil {
+				continue
+			}
+			for _, fb := range []*forms.Feedback{q.Grading.WhenRight, q.Grading.WhenWrong, q.Grading.GeneralFeedback} {
+				if fb == nil {
+					continue
+				}
+				for _, m := range fb.Material {
+					if m != nil && m.Video != nil {
+						check("feedback of the "+li.label, m.Video.YoutubeUri)
+					}
+				}
+			}
+		}
+	}
+}
+
+// isYouTubeVideoURI reports whether uri links to a YouTube video: a watch
+// URL with a v parameter, or a youtu.be, embed or shorts URL with a video ID.
+func isYouTubeVideoURI(uri string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch host {
+	case "youtu.be":
+		return len(segments) == 1 && segments[0] != ""
+	case "youtube.com", "www.youtube.com", "m.youtube.com":
+		if segments[0] == "watch" {
+			return u.Query().Get("v") != ""
+		}
+		return len(segments) == 2 && (segments[0] == "embed" || segments[0] == "shorts") && segments[1] != ""
+	default:
+		return false
+	}
+}
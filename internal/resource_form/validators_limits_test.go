@@ -0,0 +1,147 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceform
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLimitValidators_ItemBlocks(t *testing.T) {
+	t.Parallel()
+
+	manyOptions := make([]string, maxChoiceOptions+1)
+	for i := range manyOptions {
+		manyOptions[i] = fmt.Sprintf("Option %d", i)
+	}
+	points := &map[string]tftypes.Value{
+		"points":             tftypes.NewValue(tftypes.Number, maxQuestionPointValue+1),
+		"correct_answer":     tftypes.NewValue(tftypes.String, "A"),
+		"feedback_correct":   tftypes.NewValue(tftypes.String, nil),
+		"feedback_incorrect": tftypes.NewValue(tftypes.String, nil),
+	}
+
+	for _, tc := range []struct {
+		name string
+		vals map[string]tftypes.Value
+		want string
+	}{
+		{
+			name: "options",
+			vals: map[string]tftypes.Value{"item": itemListVal(t, mcItem(t, "q1", "Pick?", manyOptions, nil))},
+			want: `The item "q1" has 1001 options`,
+		},
+		{
+			name: "question title",
+			vals: map[string]tftypes.Value{"item": itemListVal(t, saItem(t, "q1", strings.Repeat("x", maxTitleLength+1), nil))},
+			want: `The title of the item "q1" has 8193 characters`,
+		},
+		{
+			name: "form title",
+			vals: map[string]tftypes.Value{"title": tftypes.NewValue(tftypes.String, strings.Repeat("x", maxTitleLength+1))},
+			want: "The form title has 8193 characters",
+		},
+		{
+			name: "points",
+			vals: map[string]tftypes.Value{
+				"quiz": tftypes.NewValue(tftypes.Bool, true),
+				"item": itemListVal(t, mcItem(t, "q1", "Pick?", []string{"A", "B"}, points)),
+			},
+			want: `The item "q1" is worth 1001 points`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			diags := runValidators(t, buildConfig(t, tc.vals),
+				ChoiceCountLimitValidator{}, TextLengthLimitValidator{}, QuizPointsLimitValidator{})
+			expectErrorContains(t, diags, tc.want)
+		})
+	}
+}
+
+func TestLimitValidators_ContentJSON(t *testing.T) {
+	t.Parallel()
+
+	var rows []string
+	for i := 0; i <= maxGridRows; i++ {
+		rows = append(rows, fmt.Sprintf(`{"rowQuestion":{"title":"Row %d"}}`, i))
+	}
+	manyItems := strings.TrimSuffix(strings.Repeat(`{"title":"T","textItem":{}},`, maxFormItems+1), ",")
+
+	for _, tc := range []struct {
+		name string
+		json string
+		want string
+	}{
+		{
+			name: "items",
+			json: "[" + manyItems + "]",
+			want: "The form has 2001 items",
+		},
+		{
+			name: "grid rows",
+			json: `[{"title":"Grid","questionGroupItem":{"grid":{"columns":{"type":"RADIO","options":[{"value":"A"}]}},"questions":[` + strings.Join(rows, ",") + `]}}]`,
+			want: "The content_json[0] has 101 rows",
+		},
+		{
+			name: "scale high",
+			json: `[{"title":"S","questionItem":{"question":{"scaleQuestion":{"low":1,"high":11}}}}]`,
+			want: "has high = 11",
+		},
+		{
+			name: "rating levels",
+			json: `[{"title":"R","questionItem":{"question":{"ratingQuestion":{"ratingScaleLevel":2,"iconType":"STAR"}}}}]`,
+			want: "has rating_scale_level = 2",
+		},
+		{
+			name: "youtube uri",
+			json: `[{"title":"V"},{"title":"V","videoItem":{"video":{"youtubeUri":"https://vimeo.com/123"}}}]`,
+			want: `The content_json[1] has youtube_uri "https://vimeo.com/123"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := buildConfig(t, map[string]tftypes.Value{
+				"content_json": tftypes.NewValue(tftypes.String, tc.json),
+			})
+			diags := runValidators(t, cfg,
+				ItemCountLimitValidator{}, ChoiceCountLimitValidator{}, ScaleAndRatingLimitValidator{}, YouTubeURIValidator{})
+			expectErrorContains(t, diags, tc.want)
+		})
+	}
+}
+
+func TestLimitValidators_WithinLimits_Passes(t *testing.T) {
+	t.Parallel()
+	cfg := buildConfig(t, map[string]tftypes.Value{
+		"title":   tftypes.NewValue(tftypes.String, "T"),
+		"item":    itemListVal(t, saItem(t, "name", "Name?", nil)),
+		"section": sectionListVal(t, sectionVal(t, "part_1", "Part 1", "", mcItem(t, "q1", "Pick?", []string{"A", "B"}, nil))),
+	})
+	diags := runValidators(t, cfg,
+		ItemCountLimitValidator{}, ChoiceCountLimitValidator{}, TextLengthLimitValidator{},
+		ScaleAndRatingLimitValidator{}, QuizPointsLimitValidator{}, YouTubeURIValidator{})
+	expectNoError(t, diags)
+}
+
+func TestIsYouTubeVideoURI(t *testing.T) {
+	t.Parallel()
+	for uri, want := range map[string]bool{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ": true,
+		"https://youtu.be/dQw4w9WgXcQ":                true,
+		"https://m.youtube.com/shorts/abc":            true,
+		"https://www.youtube.com/embed/abc":           true,
+		"https://www.youtube.com/watch":               false,
+		"https://www.youtube.com/channel/abc":         false,
+		"ftp://youtu.be/abc":                          false,
+		"https://notyoutube.com/watch?v=abc":          false,
+	} {
+		if got := isYouTubeVideoURI(uri); got != want {
+			t.Errorf("isYouTubeVideoURI(%q) = %v, want %v", uri, got, want)
+		}
+	}
+}
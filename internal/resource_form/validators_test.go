@@ -134,6 +134,18 @@ func newObjectValue(objType tftypes.Object, overrides map[string]tftypes.Value)
 	return tftypes.NewValue(objType, merged)
 }
 
+// gradingVal builds a grading block value, leaving unspecified attributes null.
+func gradingVal(t *testing.T, gType tftypes.Type, grading *map[string]tftypes.Value) tftypes.Value {
+	if grading == nil {
+		return tftypes.NewValue(gType, nil)
+	}
+	gObj, ok := gType.(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected grading to be tftypes.Object, got %T", gType)
+	}
+	return newObjectValue(gObj, *grading)
+}
+
 func mcItem(
 	t *testing.T,
 	key, questionText string,
@@ -146,10 +158,7 @@ func mcItem(
 		t.Fatalf("expected multiple_choice to be tftypes.Object, got %T", iType.AttributeTypes["multiple_choice"])
 	}
 	gType := mcType.AttributeTypes["grading"]
-	gVal := tftypes.NewValue(gType, nil)
-	if grading != nil {
-		gVal = tftypes.NewValue(gType, *grading)
-	}
+	gVal := gradingVal(t, gType, grading)
 
 	optVals := make([]tftypes.Value, len(options))
 	for i, o := range options {
@@ -165,6 +174,33 @@ func mcItem(
 	return newItemVal(iType, key, map[string]tftypes.Value{"multiple_choice": mc})
 }
 
+func cbItem(
+	t *testing.T,
+	key, questionText string,
+	options []string,
+	grading *map[string]tftypes.Value,
+) tftypes.Value {
+	iType := itemBlockType(t)
+	cbType, ok := iType.AttributeTypes["checkbox"].(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected checkbox to be tftypes.Object, got %T", iType.AttributeTypes["checkbox"])
+	}
+	gVal := gradingVal(t, cbType.AttributeTypes["grading"], grading)
+
+	optVals := make([]tftypes.Value, len(options))
+	for i, o := range options {
+		optVals[i] = tftypes.NewValue(tftypes.String, o)
+	}
+
+	cb := newObjectValue(cbType, map[string]tftypes.Value{
+		"question_text": tftypes.NewValue(tftypes.String, questionText),
+		"options":       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, optVals),
+		"required":      tftypes.NewValue(tftypes.Bool, false),
+		"grading":       gVal,
+	})
+	return newItemVal(iType, key, map[string]tftypes.Value{"checkbox": cb})
+}
+
 func saItem(t *testing.T, key, questionText string, grading *map[string]tftypes.Value) tftypes.Value {
 	iType := itemBlockType(t)
 	saType, ok := iType.AttributeTypes["short_answer"].(tftypes.Object)
@@ -172,10 +208,7 @@ func saItem(t *testing.T, key, questionText string, grading *map[string]tftypes.
 		t.Fatalf("expected short_answer to be tftypes.Object, got %T", iType.AttributeTypes["short_answer"])
 	}
 	gType := saType.AttributeTypes["grading"]
-	gVal := tftypes.NewValue(gType, nil)
-	if grading != nil {
-		gVal = tftypes.NewValue(gType, *grading)
-	}
+	gVal := gradingVal(t, gType, grading)
 
 	sa := newObjectValue(saType, map[string]tftypes.Value{
 		"question_text": tftypes.NewValue(tftypes.String, questionText),
@@ -192,10 +225,7 @@ func paraItem(t *testing.T, key, questionText string, grading *map[string]tftype
 		t.Fatalf("expected paragraph to be tftypes.Object, got %T", iType.AttributeTypes["paragraph"])
 	}
 	gType := paraType.AttributeTypes["grading"]
-	gVal := tftypes.NewValue(gType, nil)
-	if grading != nil {
-		gVal = tftypes.NewValue(gType, *grading)
-	}
+	gVal := gradingVal(t, gType, grading)
 
 	para := newObjectValue(paraType, map[string]tftypes.Value{
 		"question_text": tftypes.NewValue(tftypes.String, questionText),
@@ -297,6 +327,25 @@ func TestMutuallyExclusive_EmptyContentJSON_WithItems_Error(t *testing.T) {
 	expectErrorContains(t, diags, "Cannot use both")
 }
 
+func TestMutuallyExclusiveValidator_ContentJSONWithSourceForm_Error(t *testing.T) {
+	t.Parallel()
+	for name, sourceFormID := range map[string]tftypes.Value{
+		"known":   tftypes.NewValue(tftypes.String, "template-id"),
+		"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cfg := buildConfig(t, map[string]tftypes.Value{
+				"title":          tftypes.NewValue(tftypes.String, "T"),
+				"content_json":   tftypes.NewValue(tftypes.String, `[{"type":"short_answer"}]`),
+				"source_form_id": sourceFormID,
+			})
+			diags := runValidators(t, cfg, MutuallyExclusiveValidator{})
+			expectErrorContains(t, diags, `"content_json" and "source_form_id"`)
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // 2. AcceptingResponsesRequiresPublishedValidator
 // ---------------------------------------------------------------------------
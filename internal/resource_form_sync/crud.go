@@ -0,0 +1,317 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceformsync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+)
+
+// maxSyncPasses bounds the batchUpdate calls of one sync. The second pass
+// adds navigation to sections that the first pass created.
+const maxSyncPasses = 2
+
+func (r *FormSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan FormSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.SourceFormID.ValueString() + "#" + plan.TargetFormID.ValueString())
+	r.apply(ctx, &plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FormSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FormSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After import only the ID is known.
+	if state.SourceFormID.IsNull() || state.TargetFormID.IsNull() {
+		sourceID, targetID, diags := parseFormSyncID(state.ID.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.SourceFormID = types.StringValue(sourceID)
+		state.TargetFormID = types.StringValue(targetID)
+	}
+	if state.DeleteUnmatchedTargetItems.IsNull() {
+		state.DeleteUnmatchedTargetItems = types.BoolValue(false)
+	}
+	if state.AllowItemTypeReplacement.IsNull() {
+		state.AllowItemTypeReplacement = types.BoolValue(false)
+	}
+
+	target, err := r.client.Forms.Get(ctx, state.TargetFormID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "target form not found, removing form sync from state", map[string]interface{}{
+				"target_form_id": state.TargetFormID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Target Form", err.Error())
+		return
+	}
+	source, err := r.client.Forms.Get(ctx, state.SourceFormID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Source Form", err.Error())
+		return
+	}
+
+	idMap, diags := itemIDMapFromState(ctx, state.ItemIDMap)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	syncPlan, err := convert.PlanFormSync(source, target, idMap, syncOptions(state))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Planning Form Sync", err.Error())
+		return
+	}
+
+	state.InSync = types.BoolValue(len(syncPlan.Requests) == 0 && len(syncPlan.TypeConflicts) == 0)
+	if state.ItemIDMap.IsNull() || len(syncPlan.Requests) == 0 {
+		m, diags := types.MapValueFrom(ctx, types.StringType, syncPlan.ItemIDMap)
+		resp.Diagnostics.Append(diags...)
+		state.ItemIDMap = m
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FormSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FormSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idMap, diags := itemIDMapFromState(ctx, state.ItemIDMap)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	r.apply(ctx, &plan, idMap, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FormSyncResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// No-op: the target form keeps its synced items.
+}
+
+// ModifyPlan previews the sync of a create or update. It warns about the
+// target items the apply deletes or re-creates and fails plans that change
+// the question type of a synced item without allow_item_type_replacement.
+// Errors reading either form are left to the apply.
+func (r *FormSyncResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan FormSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.SourceFormID.IsUnknown() || plan.TargetFormID.IsUnknown() {
+		return
+	}
+
+	var idMap map[string]string
+	if !req.State.Raw.IsNull() {
+		var state FormSyncResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// A replacement starts over without the previous mapping.
+		if plan.SourceFormID.Equal(state.SourceFormID) && plan.TargetFormID.Equal(state.TargetFormID) {
+			m, diags := itemIDMapFromState(ctx, state.ItemIDMap)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			idMap = m
+		}
+	}
+
+	source, err := r.client.Forms.Get(ctx, plan.SourceFormID.ValueString())
+	if err != nil {
+		return
+	}
+	target, err := r.client.Forms.Get(ctx, plan.TargetFormID.ValueString())
+	if err != nil {
+		return
+	}
+	syncPlan, err := convert.PlanFormSync(source, target, idMap, syncOptions(plan))
+	if err != nil {
+		return
+	}
+
+	if len(syncPlan.Deleted) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Target Items Will Be Deleted",
+			fmt.Sprintf("This apply deletes %s from form %s because they have no source item. Responses already collected for deleted questions are no longer shown with the form or exported to its response spreadsheet.",
+				describeItems(syncPlan.Deleted), plan.TargetFormID.ValueString()),
+		)
+	}
+	if len(syncPlan.Replaced) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Target Items Will Be Replaced",
+			fmt.Sprintf("This apply deletes and re-creates %s in form %s because their question type changed in the source form. Responses already collected for the old questions are not carried over to the new ones.",
+				describeItems(syncPlan.Replaced), plan.TargetFormID.ValueString()),
+		)
+	}
+	if len(syncPlan.TypeConflicts) > 0 {
+		resp.Diagnostics.Append(typeConflictError(syncPlan.TypeConflicts))
+	}
+}
+
+// apply syncs the target form with the source form and records the resulting
+// item ID mapping in m.
+func (r *FormSyncResource) apply(ctx context.Context, m *FormSyncResourceModel, idMap map[string]string, diags *diag.Diagnostics) {
+	sourceID := m.SourceFormID.ValueString()
+	targetID := m.TargetFormID.ValueString()
+
+	source, err := r.client.Forms.Get(ctx, sourceID)
+	if err != nil {
+		diags.AddError("Error Reading Source Form", err.Error())
+		return
+	}
+
+	for pass := 0; pass < maxSyncPasses; pass++ {
+		target, err := r.client.Forms.Get(ctx, targetID)
+		if err != nil {
+			diags.AddError("Error Reading Target Form", err.Error())
+			return
+		}
+
+		syncPlan, err := convert.PlanFormSync(source, target, idMap, syncOptions(*m))
+		if err != nil {
+			diags.AddError("Error Planning Form Sync", err.Error())
+			return
+		}
+		if len(syncPlan.TypeConflicts) > 0 {
+			diags.Append(typeConflictError(syncPlan.TypeConflicts))
+			return
+		}
+		idMap = syncPlan.ItemIDMap
+		if len(syncPlan.Requests) == 0 {
+			break
+		}
+
+		batchReq := &forms.BatchUpdateFormRequest{Requests: syncPlan.Requests}
+		if target.RevisionId != "" {
+			batchReq.WriteControl = &forms.WriteControl{RequiredRevisionId: target.RevisionId}
+		}
+		apiResp, err := r.client.Forms.BatchUpdate(ctx, targetID, batchReq)
+		if err != nil {
+			diags.AddError("Error Syncing Google Form", fmt.Sprintf("BatchUpdate failed for form %s: %s", targetID, err))
+			return
+		}
+
+		for idx, srcItemID := range syncPlan.CreateSourceIDs {
+			if apiResp == nil || idx >= len(apiResp.Replies) || apiResp.Replies[idx] == nil || apiResp.Replies[idx].CreateItem == nil {
+				diags.AddWarning("Item Correlation Failed",
+					fmt.Sprintf("No createItem reply for source item %s; it will be matched by title on the next sync.", srcItemID))
+				continue
+			}
+			idMap[srcItemID] = apiResp.Replies[idx].CreateItem.ItemId
+		}
+		tflog.Debug(ctx, "synced form items", map[string]interface{}{
+			"source_form_id": sourceID,
+			"target_form_id": targetID,
+			"requests":       len(syncPlan.Requests),
+		})
+	}
+
+	itemIDMap, d := types.MapValueFrom(ctx, types.StringType, idMap)
+	diags.Append(d...)
+	m.ItemIDMap = itemIDMap
+	m.InSync = types.BoolValue(true)
+}
+
+// syncOptions returns the convert.PlanFormSync options configured in m.
+func syncOptions(m FormSyncResourceModel) convert.FormSyncOptions {
+	return convert.FormSyncOptions{
+		DeleteUnmatched:      m.DeleteUnmatchedTargetItems.ValueBool(),
+		AllowTypeReplacement: m.AllowItemTypeReplacement.ValueBool(),
+	}
+}
+
+// typeConflictError reports target items that are not synced because their
+// source item has another question type.
+func typeConflictError(items []*forms.Item) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Question Type Changed In Source Form",
+		fmt.Sprintf("The source items synced into %s have another question type. Syncing them deletes and re-creates the target items, which detaches their collected responses. Set allow_item_type_replacement = true to allow this.",
+			describeItems(items)),
+	)
+}
+
+// describeItems lists items by title and ID for diagnostics.
+func describeItems(items []*forms.Item) string {
+	out := make([]string, 0, len(items))
+	for _, it := range items {
+		if it.Title == "" {
+			out = append(out, "item "+it.ItemId)
+			continue
+		}
+		out = append(out, fmt.Sprintf("%q (item %s)", it.Title, it.ItemId))
+	}
+	return strings.Join(out, ", ")
+}
+
+// itemIDMapFromState returns the item_id_map, or nil when it is not known.
+func itemIDMapFromState(ctx context.Context, v types.Map) (map[string]string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+	out := map[string]string{}
+	diags := v.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+// parseFormSyncID splits a composite ID "sourceFormID#targetFormID".
+func parseFormSyncID(id string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parts := strings.SplitN(id, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		diags.AddError(
+			"Invalid Form Sync ID Format",
+			fmt.Sprintf("Expected format 'sourceFormID#targetFormID', got: %s", id),
+		)
+		return "", "", diags
+	}
+	return parts[0], parts[1], diags
+}
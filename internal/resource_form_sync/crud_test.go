@@ -0,0 +1,374 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceformsync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func testSchemaResp() resource.SchemaResponse {
+	var resp resource.SchemaResponse
+	r := &FormSyncResource{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+func objectValue(t *testing.T, vals map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	schemaResp := testSchemaResp()
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+	objType, ok := tfType.(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected tftypes.Object, got %T", tfType)
+	}
+
+	merged := make(map[string]tftypes.Value)
+	for k, v := range objType.AttributeTypes {
+		merged[k] = tftypes.NewValue(v, nil)
+	}
+	for k, v := range vals {
+		merged[k] = v
+	}
+	return tftypes.NewValue(objType, merged)
+}
+
+func buildPlan(t *testing.T, vals map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	return tfsdk.Plan{Schema: testSchemaResp().Schema, Raw: objectValue(t, vals)}
+}
+
+func buildState(t *testing.T, vals map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+	return tfsdk.State{Schema: testSchemaResp().Schema, Raw: objectValue(t, vals)}
+}
+
+func emptyState(t *testing.T) tfsdk.State {
+	t.Helper()
+	s := testSchemaResp().Schema
+	return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+}
+
+func stateModel(t *testing.T, st tfsdk.State) FormSyncResourceModel {
+	t.Helper()
+	var m FormSyncResourceModel
+	diags := st.Get(context.Background(), &m)
+	if diags.HasError() {
+		t.Fatalf("failed to decode state: %s", diags)
+	}
+	return m
+}
+
+// syncVals returns the attributes of a sync from "src" to "dst" with the
+// given options.
+func syncVals(deleteUnmatched, allowReplacement bool) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"id":                            tftypes.NewValue(tftypes.String, "src#dst"),
+		"source_form_id":                tftypes.NewValue(tftypes.String, "src"),
+		"target_form_id":                tftypes.NewValue(tftypes.String, "dst"),
+		"delete_unmatched_target_items": tftypes.NewValue(tftypes.Bool, deleteUnmatched),
+		"allow_item_type_replacement":   tftypes.NewValue(tftypes.Bool, allowReplacement),
+		"in_sync":                       tftypes.NewValue(tftypes.Bool, true),
+	}
+}
+
+func textItem(id, title string) *forms.Item {
+	return &forms.Item{
+		ItemId: id,
+		Title:  title,
+		QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{QuestionId: "q-" + id, TextQuestion: &forms.TextQuestion{}},
+		},
+	}
+}
+
+func radioItem(id, title string) *forms.Item {
+	return &forms.Item{
+		ItemId: id,
+		Title:  title,
+		QuestionItem: &forms.QuestionItem{
+			Question: &forms.Question{
+				QuestionId: "q-" + id,
+				ChoiceQuestion: &forms.ChoiceQuestion{
+					Type:    "RADIO",
+					Options: []*forms.Option{{Value: "A"}, {Value: "B"}},
+				},
+			},
+		},
+	}
+}
+
+// syncMock serves the source and target forms and records the batchUpdate
+// calls. Every batchUpdate makes the target form a copy of the source form,
+// with created items under their reply IDs, so that the second sync pass finds
+// nothing to do.
+type syncMock struct {
+	source  *forms.Form
+	target  *forms.Form
+	batches [][]*forms.Request
+}
+
+func (m *syncMock) api() *testutil.MockFormsAPI {
+	return &testutil.MockFormsAPI{
+		GetFunc: func(_ context.Context, formID string) (*forms.Form, error) {
+			if formID == "src" {
+				return m.source, nil
+			}
+			return m.target, nil
+		},
+		BatchUpdateFunc: func(_ context.Context, formID string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error) {
+			if formID != "dst" {
+				return nil, fmt.Errorf("unexpected batchUpdate of form %s", formID)
+			}
+			m.batches = append(m.batches, req.Requests)
+			replies := make([]*forms.Response, len(req.Requests))
+			created := map[string]bool{}
+			for i, r := range req.Requests {
+				if r.CreateItem != nil {
+					replies[i] = &forms.Response{CreateItem: &forms.CreateItemResponse{ItemId: "new-" + r.CreateItem.Item.Title}}
+					created[r.CreateItem.Item.Title] = true
+				}
+			}
+			target := &forms.Form{FormId: "dst"}
+			for _, it := range m.source.Items {
+				if created[it.Title] {
+					copied := *it
+					copied.ItemId = "new-" + it.Title
+					it = &copied
+				}
+				target.Items = append(target.Items, it)
+			}
+			m.target = target
+			return &forms.BatchUpdateFormResponse{Replies: replies}, nil
+		},
+	}
+}
+
+func (m *syncMock) resource() *FormSyncResource {
+	return &FormSyncResource{client: &client.Client{Forms: m.api()}}
+}
+
+func countDeletes(requests []*forms.Request) int {
+	n := 0
+	for _, r := range requests {
+		if r.DeleteItem != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func expectErrorContains(t *testing.T, diags diag.Diagnostics, want string) {
+	t.Helper()
+	for _, d := range diags.Errors() {
+		if strings.Contains(d.Summary(), want) || strings.Contains(d.Detail(), want) {
+			return
+		}
+	}
+	t.Fatalf("expected an error containing %q, got %v", want, diags)
+}
+
+func TestFormSync_Create_SyncsItems(t *testing.T) {
+	t.Parallel()
+
+	m := &syncMock{
+		source: &forms.Form{FormId: "src", Items: []*forms.Item{textItem("s1", "Name")}},
+		target: &forms.Form{FormId: "dst"},
+	}
+	r := m.resource()
+	ctx := context.Background()
+
+	vals := syncVals(false, false)
+	delete(vals, "id")
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(ctx, resource.CreateRequest{Plan: buildPlan(t, vals)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if len(m.batches) != 1 || len(m.batches[0]) != 1 || m.batches[0][0].CreateItem == nil {
+		t.Fatalf("batches = %+v, want a single create", m.batches)
+	}
+	got := stateModel(t, resp.State)
+	if got.ID.ValueString() != "src#dst" || !got.InSync.ValueBool() {
+		t.Errorf("state = %+v, want id src#dst and in_sync", got)
+	}
+	idMap := map[string]string{}
+	resp.Diagnostics.Append(got.ItemIDMap.ElementsAs(ctx, &idMap, false)...)
+	if idMap["s1"] != "new-Name" {
+		t.Errorf("item_id_map = %v, want s1 -> new-Name", idMap)
+	}
+}
+
+func TestFormSync_Update_UnmatchedTargetItems(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name            string
+		deleteUnmatched bool
+		wantDeletes     int
+	}{
+		{name: "kept by default"},
+		{name: "deleted with opt-in", deleteUnmatched: true, wantDeletes: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &syncMock{
+				source: &forms.Form{FormId: "src", Items: []*forms.Item{textItem("s1", "Name"), textItem("s2", "Email")}},
+				target: &forms.Form{FormId: "dst", Items: []*forms.Item{textItem("s1", "Name"), textItem("t9", "Added in editor")}},
+			}
+			r := m.resource()
+
+			vals := syncVals(tc.deleteUnmatched, false)
+			state := buildState(t, vals)
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(context.Background(), resource.UpdateRequest{Plan: buildPlan(t, vals), State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if len(m.batches) == 0 {
+				t.Fatal("expected a batchUpdate call")
+			}
+			if got := countDeletes(m.batches[0]); got != tc.wantDeletes {
+				t.Errorf("deleted %d items, want %d", got, tc.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestFormSync_Update_TypeChangeRequiresOptIn(t *testing.T) {
+	t.Parallel()
+
+	for _, allow := range []bool{false, true} {
+		m := &syncMock{
+			source: &forms.Form{FormId: "src", Items: []*forms.Item{radioItem("s1", "Color")}},
+			target: &forms.Form{FormId: "dst", Items: []*forms.Item{textItem("s1", "Color")}},
+		}
+		r := m.resource()
+
+		vals := syncVals(false, allow)
+		state := buildState(t, vals)
+		resp := &resource.UpdateResponse{State: state}
+		r.Update(context.Background(), resource.UpdateRequest{Plan: buildPlan(t, vals), State: state}, resp)
+
+		if !allow {
+			expectErrorContains(t, resp.Diagnostics, "allow_item_type_replacement")
+			if len(m.batches) != 0 {
+				t.Errorf("expected no batchUpdate calls, got %d", len(m.batches))
+			}
+			continue
+		}
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+		}
+		if len(m.batches) == 0 || countDeletes(m.batches[0]) != 1 {
+			t.Errorf("batches = %+v, want the item deleted and re-created", m.batches)
+		}
+	}
+}
+
+func TestFormSync_ModifyPlan_WarnsAboutDestructiveChanges(t *testing.T) {
+	t.Parallel()
+
+	m := &syncMock{
+		source: &forms.Form{FormId: "src", Items: []*forms.Item{radioItem("s1", "Color")}},
+		target: &forms.Form{FormId: "dst", Items: []*forms.Item{textItem("s1", "Color"), textItem("t9", "Added in editor")}},
+	}
+	r := m.resource()
+
+	vals := syncVals(true, true)
+	state := buildState(t, vals)
+	plan := buildPlan(t, vals)
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var summaries []string
+	for _, d := range resp.Diagnostics.Warnings() {
+		summaries = append(summaries, d.Summary())
+		if d.Summary() == "Target Items Will Be Deleted" && !strings.Contains(d.Detail(), `"Added in editor"`) {
+			t.Errorf("delete warning does not name the item: %s", d.Detail())
+		}
+		if d.Summary() == "Target Items Will Be Replaced" && !strings.Contains(d.Detail(), "deletes and re-creates \"Color\"") {
+			t.Errorf("replace warning does not name the item: %s", d.Detail())
+		}
+	}
+	if len(summaries) != 2 {
+		t.Errorf("warnings = %v, want a delete and a replace warning", summaries)
+	}
+	if len(m.batches) != 0 {
+		t.Errorf("ModifyPlan sent %d batchUpdate calls", len(m.batches))
+	}
+
+	// Without the opt-in the type change fails the plan.
+	vals = syncVals(false, false)
+	plan = buildPlan(t, vals)
+	resp = &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan, State: buildState(t, vals)}, resp)
+	expectErrorContains(t, resp.Diagnostics, "Question Type Changed In Source Form")
+	if len(resp.Diagnostics.Warnings()) != 0 {
+		t.Errorf("unexpected warnings: %v", resp.Diagnostics.Warnings())
+	}
+}
+
+func TestFormSync_Read(t *testing.T) {
+	t.Parallel()
+
+	m := &syncMock{
+		source: &forms.Form{FormId: "src", Items: []*forms.Item{textItem("s1", "Name")}},
+		target: &forms.Form{FormId: "dst", Items: []*forms.Item{textItem("s1", "Old name"), textItem("t9", "Added in editor")}},
+	}
+	r := m.resource()
+
+	// After import only the ID is known.
+	state := buildState(t, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "src#dst")})
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	got := stateModel(t, resp.State)
+	if got.SourceFormID.ValueString() != "src" || got.TargetFormID.ValueString() != "dst" {
+		t.Errorf("form IDs = %s, %s; want src, dst", got.SourceFormID, got.TargetFormID)
+	}
+	if got.DeleteUnmatchedTargetItems.ValueBool() || got.AllowItemTypeReplacement.IsNull() {
+		t.Errorf("options = %s, %s; want false", got.DeleteUnmatchedTargetItems, got.AllowItemTypeReplacement)
+	}
+	// The renamed item needs an update; the unmatched item is kept.
+	if got.InSync.ValueBool() {
+		t.Error("in_sync = true, want false")
+	}
+	if len(m.batches) != 0 {
+		t.Errorf("Read sent %d batchUpdate calls", len(m.batches))
+	}
+}
+
+func TestParseFormSyncID(t *testing.T) {
+	t.Parallel()
+
+	source, target, diags := parseFormSyncID("src#dst")
+	if diags.HasError() || source != "src" || target != "dst" {
+		t.Errorf("parseFormSyncID(src#dst) = %q, %q, %v", source, target, diags)
+	}
+	for _, id := range []string{"", "src", "#dst", "src#"} {
+		if _, _, diags := parseFormSyncID(id); !diags.HasError() {
+			t.Errorf("parseFormSyncID(%q) returned no error", id)
+		}
+	}
+}
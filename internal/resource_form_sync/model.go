@@ -0,0 +1,21 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourceformsync implements the googleforms_form_sync Terraform resource.
+package resourceformsync
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FormSyncResourceModel describes the Terraform state for googleforms_form_sync.
+type FormSyncResourceModel struct {
+	ID types.String `tfsdk:"id"`
+
+	SourceFormID types.String `tfsdk:"source_form_id"`
+	TargetFormID types.String `tfsdk:"target_form_id"`
+
+	DeleteUnmatchedTargetItems types.Bool `tfsdk:"delete_unmatched_target_items"`
+	AllowItemTypeReplacement   types.Bool `tfsdk:"allow_item_type_replacement"`
+
+	ItemIDMap types.Map  `tfsdk:"item_id_map"`
+	InSync    types.Bool `tfsdk:"in_sync"`
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceformsync
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+)
+
+// Compile-time interface checks.
+var (
+	_ resource.Resource                = &FormSyncResource{}
+	_ resource.ResourceWithImportState = &FormSyncResource{}
+	_ resource.ResourceWithModifyPlan  = &FormSyncResource{}
+)
+
+// FormSyncResource implements the googleforms_form_sync Terraform resource.
+type FormSyncResource struct {
+	client *client.Client
+}
+
+func NewFormSyncResource() resource.Resource {
+	return &FormSyncResource{}
+}
+
+func (r *FormSyncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_form_sync"
+}
+
+func (r *FormSyncResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *client.Client, got unexpected type.",
+		)
+		return
+	}
+	r.client = c
+}
+
+// ImportState handles terraform import for form syncs.
+// Usage: terraform import googleforms_form_sync.example SOURCE_FORM_ID#TARGET_FORM_ID
+func (r *FormSyncResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
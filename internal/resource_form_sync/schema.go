@@ -0,0 +1,79 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceformsync
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Schema defines the Terraform schema for googleforms_form_sync.
+func (r *FormSyncResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Mirrors the items and quiz setting of a source form into a target form, for example " +
+			"to promote a form edited in staging to production. Each apply sends targeted updates to the " +
+			"target form. Target items keep their IDs, and so their responses, when they correlate with a " +
+			"source item: through item_id_map, by equal item ID (for example after a Drive copy), or by equal " +
+			"title and question type. Target items without a source item are kept unless " +
+			"delete_unmatched_target_items is set, and an item whose question type changed in the source form " +
+			"fails the plan unless allow_item_type_replacement is set. Plans warn about every target item that " +
+			"the apply deletes or re-creates. Destroying the resource leaves the target form unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Composite ID of the form `SOURCE_FORM_ID#TARGET_FORM_ID`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_form_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The form whose items are copied.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_form_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The form that is updated to match the source form. Its title, description and settings other than quiz mode are left unchanged.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_unmatched_target_items": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Delete target items that correlate with no source item, for example questions removed from the source form or added to the target form in the editor. Responses collected for deleted questions are detached from the form. When false, such items are left in place after the synced items.",
+			},
+			"allow_item_type_replacement": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow target items whose source item changed question type to be deleted and re-created. Responses collected for the old question are detached from the new one. When false, such a change fails the plan.",
+			},
+			"item_id_map": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Map of source item IDs to the IDs of the target items they are synced into.",
+			},
+			"in_sync": schema.BoolAttribute{
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "Whether the target form matched the source form when last read. " +
+					"A change to either form shows up as an update to this attribute.",
+			},
+		},
+	}
+}
@@ -63,8 +63,19 @@ func (r *SheetResource) Create(
 		return
 	}
 
-	// Extract the sheet ID from the AddSheet reply.
-	addedProps := batchResp.Replies[0].AddSheet.Properties
+	// Extract the sheet ID from the AddSheet reply. If the reply is missing,
+	// look the new sheet up by title so it is not orphaned.
+	addedProps := addedSheetProperties(batchResp)
+	if addedProps == nil {
+		addedProps = r.findSheetByTitle(ctx, spreadsheetID, plan.Title.ValueString())
+	}
+	if addedProps == nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Sheet",
+			fmt.Sprintf("A sheet titled %q was added to spreadsheet %s but its sheet ID could not be determined. Import it with terraform import.", plan.Title.ValueString(), spreadsheetID),
+		)
+		return
+	}
 	sheetID := addedProps.SheetId
 
 	tflog.Info(ctx, "created sheet", map[string]interface{}{
@@ -76,10 +87,62 @@ func (r *SheetResource) Create(
 	plan.ID = types.StringValue(fmt.Sprintf("%s#%d", spreadsheetID, sheetID))
 	plan.SheetID = types.Int64Value(sheetID)
 	plan.Index = types.Int64Value(int64(addedProps.Index))
-	plan.RowCount = types.Int64Value(addedProps.GridProperties.RowCount)
-	plan.ColumnCount = types.Int64Value(addedProps.GridProperties.ColumnCount)
+	if addedProps.GridProperties != nil {
+		plan.RowCount = types.Int64Value(addedProps.GridProperties.RowCount)
+		plan.ColumnCount = types.Int64Value(addedProps.GridProperties.ColumnCount)
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	state := partialCreateState(plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// partialCreateState returns plan with every value the API did not report
+// saved as null. Saving unknown values would make Terraform reject the state
+// and orphan the new sheet; the next refresh reads the missing values.
+func partialCreateState(plan SheetResourceModel) SheetResourceModel {
+	state := plan
+	if state.RowCount.IsUnknown() {
+		state.RowCount = types.Int64Null()
+	}
+	if state.ColumnCount.IsUnknown() {
+		state.ColumnCount = types.Int64Null()
+	}
+	if state.Index.IsUnknown() {
+		state.Index = types.Int64Null()
+	}
+	if state.DeletionProtection.IsUnknown() {
+		state.DeletionProtection = types.BoolNull()
+	}
+	return state
+}
+
+// addedSheetProperties returns the properties from the AddSheet reply of a
+// batchUpdate, or nil if the reply is missing.
+func addedSheetProperties(batchResp *sheets.BatchUpdateSpreadsheetResponse) *sheets.SheetProperties {
+	if batchResp == nil || len(batchResp.Replies) == 0 || batchResp.Replies[0] == nil ||
+		batchResp.Replies[0].AddSheet == nil {
+		return nil
+	}
+	return batchResp.Replies[0].AddSheet.Properties
+}
+
+// findSheetByTitle returns the properties of the sheet with the given title,
+// or nil if it cannot be found.
+func (r *SheetResource) findSheetByTitle(ctx context.Context, spreadsheetID, title string) *sheets.SheetProperties {
+	spreadsheet, err := r.client.Sheets.Get(ctx, spreadsheetID)
+	if err != nil {
+		tflog.Warn(ctx, "could not look up added sheet", map[string]interface{}{
+			"spreadsheet_id": spreadsheetID,
+			"error":          err.Error(),
+		})
+		return nil
+	}
+	for _, sh := range spreadsheet.Sheets {
+		if sh != nil && sh.Properties != nil && sh.Properties.Title == title {
+			return sh.Properties
+		}
+	}
+	return nil
 }
 
 // Read fetches the current state of a sheet from the Sheets API.
@@ -252,6 +315,14 @@ func (r *SheetResource) Delete(
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Sheet %s has deletion_protection = true. Set deletion_protection = false and apply before deleting it.", state.ID.ValueString()),
+		)
+		return
+	}
+
 	spreadsheetID, sheetID, diags := parseSheetID(state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -333,3 +404,40 @@ func parseSheetID(id string) (string, int64, diag.Diagnostics) {
 
 	return parts[0], sheetID, diags
 }
+
+// ModifyPlan fails plans that delete or replace a sheet while
+// deletion_protection is set.
+func (r *SheetResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state SheetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.DeletionProtection.ValueBool() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Sheet %s has deletion_protection = true. Set deletion_protection = false and apply before deleting it.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	var plan SheetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.SpreadsheetID.Equal(state.SpreadsheetID) {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Changing spreadsheet_id replaces sheet %s, which has deletion_protection = true. Set deletion_protection = false and apply before replacing it.", state.ID.ValueString()),
+		)
+	}
+}
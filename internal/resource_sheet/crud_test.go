@@ -0,0 +1,145 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcesheet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sheets "google.golang.org/api/sheets/v4"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func testSchemaResp() resource.SchemaResponse {
+	var resp resource.SchemaResponse
+	r := &SheetResource{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+func buildPlan(t *testing.T, vals map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	s := testSchemaResp().Schema
+	objType, ok := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected tftypes.Object")
+	}
+	merged := make(map[string]tftypes.Value)
+	for k, v := range objType.AttributeTypes {
+		merged[k] = tftypes.NewValue(v, nil)
+	}
+	for k, v := range vals {
+		merged[k] = v
+	}
+	return tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objType, merged)}
+}
+
+func emptyState(t *testing.T) tfsdk.State {
+	t.Helper()
+	s := testSchemaResp().Schema
+	return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+}
+
+func stateModel(t *testing.T, st tfsdk.State) SheetResourceModel {
+	t.Helper()
+	var m SheetResourceModel
+	if diags := st.Get(context.Background(), &m); diags.HasError() {
+		t.Fatalf("failed to decode state: %s", diags)
+	}
+	return m
+}
+
+func sheetPlan(t *testing.T) tfsdk.Plan {
+	return buildPlan(t, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"spreadsheet_id":      tftypes.NewValue(tftypes.String, "ss1"),
+		"title":               tftypes.NewValue(tftypes.String, "Data"),
+		"row_count":           tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"column_count":        tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, true),
+		"sheet_id":            tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"index":               tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	})
+}
+
+func TestSheet_Create_WithoutGridProperties_SavesNullCounts(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		BatchUpdateFunc: func(_ context.Context, _ string, _ *sheets.BatchUpdateSpreadsheetRequest) (*sheets.BatchUpdateSpreadsheetResponse, error) {
+			return &sheets.BatchUpdateSpreadsheetResponse{Replies: []*sheets.Response{
+				{AddSheet: &sheets.AddSheetResponse{Properties: &sheets.SheetProperties{SheetId: 42, Index: 1, Title: "Data"}}},
+			}}, nil
+		},
+	}
+	r := &SheetResource{client: &client.Client{Sheets: mockSheets}}
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: sheetPlan(t)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	m := stateModel(t, resp.State)
+	if m.ID.ValueString() != "ss1#42" || m.SheetID.ValueInt64() != 42 || m.Index.ValueInt64() != 1 {
+		t.Errorf("id = %s sheet_id = %s index = %s", m.ID, m.SheetID, m.Index)
+	}
+	if !m.RowCount.IsNull() || !m.ColumnCount.IsNull() {
+		t.Errorf("row_count = %s column_count = %s, want null", m.RowCount, m.ColumnCount)
+	}
+}
+
+func TestSheet_Create_MissingReply_LooksUpByTitle(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		BatchUpdateFunc: func(_ context.Context, _ string, _ *sheets.BatchUpdateSpreadsheetRequest) (*sheets.BatchUpdateSpreadsheetResponse, error) {
+			return &sheets.BatchUpdateSpreadsheetResponse{}, nil
+		},
+		GetFunc: func(_ context.Context, _ string) (*sheets.Spreadsheet, error) {
+			return &sheets.Spreadsheet{Sheets: []*sheets.Sheet{
+				{Properties: &sheets.SheetProperties{SheetId: 0, Title: "Sheet1"}},
+				{Properties: &sheets.SheetProperties{SheetId: 7, Index: 1, Title: "Data", GridProperties: &sheets.GridProperties{RowCount: 1000, ColumnCount: 26}}},
+			}}, nil
+		},
+	}
+	r := &SheetResource{client: &client.Client{Sheets: mockSheets}}
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: sheetPlan(t)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	m := stateModel(t, resp.State)
+	if m.ID.ValueString() != "ss1#7" || m.RowCount.ValueInt64() != 1000 || m.ColumnCount.ValueInt64() != 26 {
+		t.Errorf("id = %s row_count = %s column_count = %s", m.ID, m.RowCount, m.ColumnCount)
+	}
+}
+
+func TestSheet_Create_UnknownSheet_ReportsError(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		BatchUpdateFunc: func(_ context.Context, _ string, _ *sheets.BatchUpdateSpreadsheetRequest) (*sheets.BatchUpdateSpreadsheetResponse, error) {
+			return &sheets.BatchUpdateSpreadsheetResponse{}, nil
+		},
+		GetFunc: func(_ context.Context, _ string) (*sheets.Spreadsheet, error) {
+			return &sheets.Spreadsheet{}, nil
+		},
+	}
+	r := &SheetResource{client: &client.Client{Sheets: mockSheets}}
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: sheetPlan(t)}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the new sheet cannot be found")
+	}
+}
@@ -8,11 +8,12 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // SheetResourceModel describes the Terraform state for googleforms_sheet.
 type SheetResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	SpreadsheetID types.String `tfsdk:"spreadsheet_id"`
-	Title         types.String `tfsdk:"title"`
-	RowCount      types.Int64  `tfsdk:"row_count"`
-	ColumnCount   types.Int64  `tfsdk:"column_count"`
-	SheetID       types.Int64  `tfsdk:"sheet_id"`
-	Index         types.Int64  `tfsdk:"index"`
+	ID                 types.String `tfsdk:"id"`
+	SpreadsheetID      types.String `tfsdk:"spreadsheet_id"`
+	Title              types.String `tfsdk:"title"`
+	RowCount           types.Int64  `tfsdk:"row_count"`
+	ColumnCount        types.Int64  `tfsdk:"column_count"`
+	SheetID            types.Int64  `tfsdk:"sheet_id"`
+	Index              types.Int64  `tfsdk:"index"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
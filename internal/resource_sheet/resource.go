@@ -15,6 +15,7 @@ import (
 var (
 	_ resource.Resource                = &SheetResource{}
 	_ resource.ResourceWithImportState = &SheetResource{}
+	_ resource.ResourceWithModifyPlan  = &SheetResource{}
 )
 
 // SheetResource implements the googleforms_sheet Terraform resource.
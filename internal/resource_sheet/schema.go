@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -58,6 +59,12 @@ func sheetAttributes() map[string]schema.Attribute {
 			Default:     int64default.StaticInt64(26),
 			Description: "The number of columns in the sheet grid. Defaults to 26.",
 		},
+		"deletion_protection": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "While true (the default), plans that delete the sheet or replace it (a new spreadsheet_id) fail. Set it to false and apply before deleting the sheet.",
+		},
 		"sheet_id": schema.Int64Attribute{
 			Computed:    true,
 			Description: "Google's internal sheet ID within the spreadsheet.",
@@ -6,6 +6,7 @@ package resourcespreadsheet
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/45ck/terraform-provider-googleforms/internal/client"
 
+	drive "google.golang.org/api/drive/v3"
 	sheets "google.golang.org/api/sheets/v4"
 )
 
@@ -47,28 +49,49 @@ func (r *SpreadsheetResource) Create(
 		spreadsheet.Properties.TimeZone = plan.TimeZone.ValueString()
 	}
 
-	created, err := r.client.Sheets.Create(ctx, spreadsheet)
-	if err != nil {
-		resp.Diagnostics.AddError("Create Spreadsheet Failed", err.Error())
-		return
-	}
+	copying := !plan.SourceSpreadsheetID.IsNull() && !plan.SourceSpreadsheetID.IsUnknown() && plan.SourceSpreadsheetID.ValueString() != ""
+	wantLocale, wantTimeZone := plan.Locale, plan.TimeZone
 
-	// Partial state save: write ID immediately so Terraform can track the
-	// resource even if a subsequent step fails.
-	plan.ID = types.StringValue(created.SpreadsheetId)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	var created *sheets.Spreadsheet
+	var err error
+	if copying {
+		var copyID string
+		copyID, err = r.copySourceSpreadsheet(ctx, plan, supportsAllDrives)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Spreadsheet Failed", err.Error())
+			return
+		}
 
-	// Optional: move the spreadsheet into a Drive folder.
-	if !plan.FolderID.IsNull() && !plan.FolderID.IsUnknown() && plan.FolderID.ValueString() != "" {
-		if err := r.client.Drive.MoveToFolder(ctx, created.SpreadsheetId, plan.FolderID.ValueString(), supportsAllDrives); err != nil {
-			resp.Diagnostics.AddError("Move Spreadsheet To Folder Failed", err.Error())
+		// Save the ID of the copy before reading it, so that a failed read
+		// does not orphan the copy. The rest is filled in below.
+		copied := partialCreateState(plan)
+		copied.ID = types.StringValue(copyID)
+		copied.URL = types.StringNull()
+		copied.Locale = types.StringNull()
+		copied.TimeZone = types.StringNull()
+		copied.FolderID = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &copied)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		created, err = r.client.Sheets.Get(ctx, copyID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read Spreadsheet Failed",
+				fmt.Sprintf("Spreadsheet was copied (ID: %s) but could not be read: %s", copyID, err),
+			)
+			return
+		}
+	} else {
+		created, err = r.client.Sheets.Create(ctx, spreadsheet)
+		if err != nil {
+			resp.Diagnostics.AddError("Create Spreadsheet Failed", err.Error())
 			return
 		}
 	}
 
+	plan.ID = types.StringValue(created.SpreadsheetId)
 	plan.URL = types.StringValue(created.SpreadsheetUrl)
 	if created.Properties != nil {
 		plan.Title = types.StringValue(created.Properties.Title)
@@ -80,6 +103,44 @@ func (r *SpreadsheetResource) Create(
 		}
 	}
 
+	// Partial state save: write ID immediately so Terraform can track the
+	// resource even if a subsequent step fails. Values that are not known
+	// yet are saved as null, and the folder move is recorded as not applied.
+	partial := partialCreateState(plan)
+	partial.FolderID = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &partial)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A copy keeps the locale and time zone of the template unless they are
+	// configured.
+	if copying {
+		if propsReq := copiedPropertiesRequest(wantLocale, wantTimeZone, created.Properties); propsReq != nil {
+			_, err := r.client.Sheets.BatchUpdate(ctx, created.SpreadsheetId, &sheets.BatchUpdateSpreadsheetRequest{
+				Requests: []*sheets.Request{propsReq},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Update Spreadsheet Failed", err.Error())
+				return
+			}
+			if !wantLocale.IsNull() && !wantLocale.IsUnknown() {
+				plan.Locale = wantLocale
+			}
+			if !wantTimeZone.IsNull() && !wantTimeZone.IsUnknown() {
+				plan.TimeZone = wantTimeZone
+			}
+		}
+	}
+
+	// Optional: move the spreadsheet into a Drive folder.
+	if !plan.FolderID.IsNull() && !plan.FolderID.IsUnknown() && plan.FolderID.ValueString() != "" {
+		if err := r.client.Drive.MoveToFolder(ctx, created.SpreadsheetId, plan.FolderID.ValueString(), supportsAllDrives); err != nil {
+			resp.Diagnostics.AddError("Move Spreadsheet To Folder Failed", err.Error())
+			return
+		}
+	}
+
 	// Best-effort: record current parents.
 	if parents, err := r.client.Drive.GetParents(ctx, created.SpreadsheetId, supportsAllDrives); err == nil {
 		lv, diags := types.ListValueFrom(ctx, types.StringType, parents)
@@ -90,10 +151,40 @@ func (r *SpreadsheetResource) Create(
 		plan.ParentIDs = types.ListNull(types.StringType)
 	}
 
+	plan = partialCreateState(plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	tflog.Debug(ctx, "created spreadsheet", map[string]interface{}{"id": created.SpreadsheetId})
 }
 
+// partialCreateState returns plan with every value that is not known yet,
+// such as a locale or time zone the API returned empty, saved as null. Saving
+// unknown values would make Terraform reject the state.
+func partialCreateState(plan SpreadsheetResourceModel) SpreadsheetResourceModel {
+	state := plan
+	if state.Locale.IsUnknown() {
+		state.Locale = types.StringNull()
+	}
+	if state.TimeZone.IsUnknown() {
+		state.TimeZone = types.StringNull()
+	}
+	if state.URL.IsUnknown() {
+		state.URL = types.StringNull()
+	}
+	if state.ParentIDs.IsUnknown() {
+		state.ParentIDs = types.ListNull(types.StringType)
+	}
+	if state.SupportsAllDrives.IsUnknown() {
+		state.SupportsAllDrives = types.BoolNull()
+	}
+	if state.DeletionPolicy.IsUnknown() {
+		state.DeletionPolicy = types.StringNull()
+	}
+	if state.DeletionProtection.IsUnknown() {
+		state.DeletionProtection = types.BoolNull()
+	}
+	return state
+}
+
 // Read refreshes the Terraform state from the Google Sheets API.
 func (r *SpreadsheetResource) Read(
 	ctx context.Context,
@@ -237,8 +328,35 @@ func (r *SpreadsheetResource) Delete(
 		return
 	}
 
-	// Use Drive API to delete the spreadsheet file.
-	err := r.client.Drive.Delete(ctx, state.ID.ValueString())
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Spreadsheet %s has deletion_protection = true. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	policy := "trash"
+	if !state.DeletionPolicy.IsNull() && !state.DeletionPolicy.IsUnknown() && state.DeletionPolicy.ValueString() != "" {
+		policy = state.DeletionPolicy.ValueString()
+	}
+	if policy == "abandon" {
+		tflog.Info(ctx, "abandoning spreadsheet; it is removed from state only", map[string]interface{}{"id": state.ID.ValueString()})
+		return
+	}
+
+	supportsAllDrives := false
+	if !state.SupportsAllDrives.IsNull() && !state.SupportsAllDrives.IsUnknown() {
+		supportsAllDrives = state.SupportsAllDrives.ValueBool()
+	}
+
+	// Use Drive API to trash or delete the spreadsheet file.
+	var err error
+	if policy == "delete" {
+		err = r.client.Drive.Delete(ctx, state.ID.ValueString())
+	} else {
+		err = r.client.Drive.Trash(ctx, state.ID.ValueString(), supportsAllDrives)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Delete Spreadsheet Failed",
@@ -247,7 +365,44 @@ func (r *SpreadsheetResource) Delete(
 		return
 	}
 
-	tflog.Debug(ctx, "deleted spreadsheet", map[string]interface{}{"id": state.ID.ValueString()})
+	tflog.Debug(ctx, "deleted spreadsheet", map[string]interface{}{"id": state.ID.ValueString(), "deletion_policy": policy})
+}
+
+// ModifyPlan fails plans that destroy or replace a spreadsheet while
+// deletion_protection is set.
+func (r *SpreadsheetResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state SpreadsheetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.DeletionProtection.ValueBool() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Spreadsheet %s has deletion_protection = true. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString()),
+		)
+		return
+	}
+
+	var plan SpreadsheetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.SourceSpreadsheetID.Equal(state.SourceSpreadsheetID) {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Changing source_spreadsheet_id replaces spreadsheet %s, which has deletion_protection = true. Set deletion_protection = false and apply before replacing it.", state.ID.ValueString()),
+		)
+	}
 }
 
 // ImportState handles terraform import for existing Google Sheets spreadsheets.
@@ -259,3 +414,49 @@ func (r *SpreadsheetResource) ImportState(
 ) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// copySourceSpreadsheet creates the spreadsheet as a Drive copy of
+// source_spreadsheet_id and returns the ID of the copy.
+func (r *SpreadsheetResource) copySourceSpreadsheet(
+	ctx context.Context,
+	plan SpreadsheetResourceModel,
+	supportsAllDrives bool,
+) (string, error) {
+	sourceID := plan.SourceSpreadsheetID.ValueString()
+	f, err := r.client.Drive.CopyFile(ctx, sourceID, &drive.File{Name: plan.Title.ValueString()}, supportsAllDrives)
+	if err != nil {
+		return "", fmt.Errorf("could not copy source spreadsheet %s: %w", sourceID, r.client.CopySourceError(err))
+	}
+	if f == nil || f.Id == "" {
+		return "", fmt.Errorf("copy of spreadsheet %s returned no file ID", sourceID)
+	}
+	return f.Id, nil
+}
+
+// copiedPropertiesRequest returns the request that applies the configured
+// locale and time zone to a copied spreadsheet, or nil when it already has
+// them.
+func copiedPropertiesRequest(locale, timeZone types.String, current *sheets.SpreadsheetProperties) *sheets.Request {
+	if current == nil {
+		current = &sheets.SpreadsheetProperties{}
+	}
+	props := &sheets.SpreadsheetProperties{}
+	var fields []string
+	if !locale.IsNull() && !locale.IsUnknown() && locale.ValueString() != current.Locale {
+		props.Locale = locale.ValueString()
+		fields = append(fields, "locale")
+	}
+	if !timeZone.IsNull() && !timeZone.IsUnknown() && timeZone.ValueString() != current.TimeZone {
+		props.TimeZone = timeZone.ValueString()
+		fields = append(fields, "timeZone")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &sheets.Request{
+		UpdateSpreadsheetProperties: &sheets.UpdateSpreadsheetPropertiesRequest{
+			Properties: props,
+			Fields:     strings.Join(fields, ","),
+		},
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcespreadsheet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	drive "google.golang.org/api/drive/v3"
+	sheets "google.golang.org/api/sheets/v4"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/testutil"
+)
+
+func testSchemaResp() resource.SchemaResponse {
+	var resp resource.SchemaResponse
+	r := &SpreadsheetResource{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return resp
+}
+
+func buildPlan(t *testing.T, vals map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	s := testSchemaResp().Schema
+	objType, ok := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected tftypes.Object")
+	}
+	merged := make(map[string]tftypes.Value)
+	for k, v := range objType.AttributeTypes {
+		merged[k] = tftypes.NewValue(v, nil)
+	}
+	for k, v := range vals {
+		merged[k] = v
+	}
+	return tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objType, merged)}
+}
+
+func emptyState(t *testing.T) tfsdk.State {
+	t.Helper()
+	s := testSchemaResp().Schema
+	return tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+}
+
+func stateModel(t *testing.T, st tfsdk.State) SpreadsheetResourceModel {
+	t.Helper()
+	var m SpreadsheetResourceModel
+	if diags := st.Get(context.Background(), &m); diags.HasError() {
+		t.Fatalf("failed to decode state: %s", diags)
+	}
+	return m
+}
+
+func createdSpreadsheet() *sheets.Spreadsheet {
+	return &sheets.Spreadsheet{
+		SpreadsheetId:  "ss1",
+		SpreadsheetUrl: "https://docs.google.com/spreadsheets/d/ss1",
+		Properties:     &sheets.SpreadsheetProperties{Title: "Budget"},
+	}
+}
+
+func TestSpreadsheet_Create_FolderMoveFails_SavesPartialState(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		CreateFunc: func(_ context.Context, _ *sheets.Spreadsheet) (*sheets.Spreadsheet, error) {
+			return createdSpreadsheet(), nil
+		},
+	}
+	mockDrive := &testutil.MockDriveAPI{
+		MoveToFolderFunc: func(_ context.Context, _, _ string, _ bool) error {
+			return errors.New("folder not found")
+		},
+	}
+	r := &SpreadsheetResource{client: &client.Client{Sheets: mockSheets, Drive: mockDrive}}
+
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"title":               tftypes.NewValue(tftypes.String, "Budget"),
+		"locale":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"time_zone":           tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"folder_id":           tftypes.NewValue(tftypes.String, "folder1"),
+		"supports_all_drives": tftypes.NewValue(tftypes.Bool, false),
+		"deletion_policy":     tftypes.NewValue(tftypes.String, "trash"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, true),
+		"parent_ids":          tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"url":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the folder move fails")
+	}
+	for _, d := range resp.Diagnostics.Errors() {
+		if d.Summary() != "Move Spreadsheet To Folder Failed" {
+			t.Errorf("unexpected error: %s: %s", d.Summary(), d.Detail())
+		}
+	}
+	m := stateModel(t, resp.State)
+	if m.ID.ValueString() != "ss1" {
+		t.Errorf("id = %s, want ss1", m.ID)
+	}
+	if !m.FolderID.IsNull() {
+		t.Errorf("folder_id = %s, want null until the move succeeds", m.FolderID)
+	}
+	if !m.Locale.IsNull() || !m.TimeZone.IsNull() || !m.ParentIDs.IsNull() {
+		t.Errorf("unknown values must be saved as null, got locale=%s time_zone=%s parent_ids=%s", m.Locale, m.TimeZone, m.ParentIDs)
+	}
+}
+
+// copyPlan is the plan of a spreadsheet copied from a template, with locale
+// and time zone left to the template.
+func copyPlan(t *testing.T) tfsdk.Plan {
+	return buildPlan(t, map[string]tftypes.Value{
+		"id":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"title":                 tftypes.NewValue(tftypes.String, "Budget"),
+		"source_spreadsheet_id": tftypes.NewValue(tftypes.String, "template"),
+		"locale":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"time_zone":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"supports_all_drives":   tftypes.NewValue(tftypes.Bool, false),
+		"deletion_policy":       tftypes.NewValue(tftypes.String, "trash"),
+		"deletion_protection":   tftypes.NewValue(tftypes.Bool, true),
+		"parent_ids":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"url":                   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+}
+
+func copyDrive() *testutil.MockDriveAPI {
+	return &testutil.MockDriveAPI{
+		CopyFileFunc: func(_ context.Context, _ string, _ *drive.File, _ bool) (*drive.File, error) {
+			return &drive.File{Id: "ss1"}, nil
+		},
+	}
+}
+
+func TestSpreadsheet_Create_CopyReadFails_SavesCopyID(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		GetFunc: func(_ context.Context, _ string) (*sheets.Spreadsheet, error) {
+			return nil, errors.New("backend error")
+		},
+	}
+	r := &SpreadsheetResource{client: &client.Client{Sheets: mockSheets, Drive: copyDrive()}}
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: copyPlan(t)}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Read Spreadsheet Failed" {
+		t.Fatalf("expected a read error, got %v", resp.Diagnostics)
+	}
+	m := stateModel(t, resp.State)
+	if m.ID.ValueString() != "ss1" {
+		t.Errorf("id = %s, want the copy ss1 in state", m.ID)
+	}
+	if !m.URL.IsNull() || !m.Locale.IsNull() || !m.TimeZone.IsNull() {
+		t.Errorf("url, locale and time_zone must be null until the copy is read, got %s %s %s", m.URL, m.Locale, m.TimeZone)
+	}
+}
+
+func TestSpreadsheet_Create_Copy(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		GetFunc: func(_ context.Context, id string) (*sheets.Spreadsheet, error) {
+			ss := createdSpreadsheet()
+			ss.SpreadsheetId = id
+			ss.Properties.Locale = "en_GB"
+			ss.Properties.TimeZone = "Europe/London"
+			return ss, nil
+		},
+	}
+	r := &SpreadsheetResource{client: &client.Client{Sheets: mockSheets, Drive: copyDrive()}}
+
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: copyPlan(t)}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	m := stateModel(t, resp.State)
+	if m.ID.ValueString() != "ss1" || m.URL.ValueString() == "" || m.Locale.ValueString() != "en_GB" || m.TimeZone.ValueString() != "Europe/London" {
+		t.Errorf("state = id %s url %s locale %s time_zone %s, want the copy's values", m.ID, m.URL, m.Locale, m.TimeZone)
+	}
+}
+
+func TestSpreadsheet_Create_Success(t *testing.T) {
+	t.Parallel()
+
+	mockSheets := &testutil.MockSheetsAPI{
+		CreateFunc: func(_ context.Context, s *sheets.Spreadsheet) (*sheets.Spreadsheet, error) {
+			if s.Properties.Title != "Budget" {
+				t.Errorf("title = %q, want Budget", s.Properties.Title)
+			}
+			return createdSpreadsheet(), nil
+		},
+	}
+	mockDrive := &testutil.MockDriveAPI{
+		GetParentsFunc: func(_ context.Context, _ string, _ bool) ([]string, error) {
+			return []string{"root"}, nil
+		},
+	}
+	r := &SpreadsheetResource{client: &client.Client{Sheets: mockSheets, Drive: mockDrive}}
+
+	plan := buildPlan(t, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"title":               tftypes.NewValue(tftypes.String, "Budget"),
+		"supports_all_drives": tftypes.NewValue(tftypes.Bool, false),
+		"deletion_policy":     tftypes.NewValue(tftypes.String, "trash"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, true),
+		"parent_ids":          tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"url":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{State: emptyState(t)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	m := stateModel(t, resp.State)
+	if m.ID.ValueString() != "ss1" || m.URL.ValueString() != "https://docs.google.com/spreadsheets/d/ss1" {
+		t.Errorf("id = %s url = %s", m.ID, m.URL)
+	}
+	var parents []string
+	m.ParentIDs.ElementsAs(context.Background(), &parents, false)
+	if len(parents) != 1 || parents[0] != "root" {
+		t.Errorf("parent_ids = %v, want [root]", parents)
+	}
+}
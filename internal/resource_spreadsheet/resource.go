@@ -15,6 +15,7 @@ import (
 var (
 	_ resource.Resource                = &SpreadsheetResource{}
 	_ resource.ResourceWithImportState = &SpreadsheetResource{}
+	_ resource.ResourceWithModifyPlan  = &SpreadsheetResource{}
 )
 
 // SpreadsheetResource implements the googleforms_spreadsheet Terraform resource.
@@ -6,11 +6,14 @@ package resourcespreadsheet
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -46,12 +49,34 @@ func (r *SpreadsheetResource) Schema(
 				Optional:    true,
 				Description: "Drive folder ID to place the spreadsheet into. If set, the provider will move the spreadsheet file into this folder.",
 			},
+			"source_spreadsheet_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of a template spreadsheet to create this spreadsheet from with a Drive copy, keeping its sheets, formatting and formulas. Templates the provider did not create, such as spreadsheets made in the Sheets UI or shared with its account, can only be copied with read_drive_files = true in the provider configuration. Changing it forces a new spreadsheet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"supports_all_drives": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 				Description: "Whether to support shared drives when moving the file into folder_id.",
 			},
+			"deletion_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("trash"),
+				Description: "What destroying the resource does to the spreadsheet. 'trash' (default) moves it to the Drive trash, where it can be restored for 30 days. 'delete' deletes it permanently. 'abandon' only removes it from Terraform state.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "trash", "abandon"),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "While true (the default), plans that destroy or replace the spreadsheet fail. Set it to false and apply before destroying it.",
+			},
 			"parent_ids": schema.ListAttribute{
 				Computed:    true,
 				Description: "Current Drive parent folder IDs for the spreadsheet (best-effort).",
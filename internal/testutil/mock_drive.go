@@ -13,6 +13,7 @@ import (
 // MockDriveAPI is a configurable mock implementation of client.DriveAPI.
 type MockDriveAPI struct {
 	DeleteFunc func(ctx context.Context, fileID string) error
+	TrashFunc  func(ctx context.Context, fileID string, supportsAllDrives bool) error
 
 	GetParentsFunc   func(ctx context.Context, fileID string, supportsAllDrives bool) ([]string, error)
 	MoveToFolderFunc func(ctx context.Context, fileID string, folderID string, supportsAllDrives bool) error
@@ -23,8 +24,13 @@ type MockDriveAPI struct {
 
 	GetFileFunc    func(ctx context.Context, fileID string, supportsAllDrives bool) (*drive.File, error)
 	CreateFileFunc func(ctx context.Context, f *drive.File, supportsAllDrives bool) (*drive.File, error)
+	CopyFileFunc   func(ctx context.Context, fileID string, f *drive.File, supportsAllDrives bool) (*drive.File, error)
+	UploadFileFunc func(ctx context.Context, f *drive.File, contentType string, content []byte, supportsAllDrives bool) (*drive.File, error)
 	UpdateFileFunc func(ctx context.Context, fileID string, f *drive.File, addParents string, removeParents string, supportsAllDrives bool) (*drive.File, error)
 	ListFilesFunc  func(ctx context.Context, q string, supportsAllDrives bool) ([]*drive.File, error)
+
+	GetAppPropertiesFunc    func(ctx context.Context, fileID string, supportsAllDrives bool) (map[string]string, error)
+	UpdateAppPropertiesFunc func(ctx context.Context, fileID string, set map[string]string, remove []string, supportsAllDrives bool) error
 }
 
 var _ client.DriveAPI = &MockDriveAPI{}
@@ -36,6 +42,13 @@ func (m *MockDriveAPI) Delete(ctx context.Context, fileID string) error {
 	return nil
 }
 
+func (m *MockDriveAPI) Trash(ctx context.Context, fileID string, supportsAllDrives bool) error {
+	if m.TrashFunc != nil {
+		return m.TrashFunc(ctx, fileID, supportsAllDrives)
+	}
+	return nil
+}
+
 func (m *MockDriveAPI) GetParents(
 	ctx context.Context,
 	fileID string,
@@ -111,6 +124,20 @@ func (m *MockDriveAPI) CreateFile(ctx context.Context, f *drive.File, supportsAl
 	return &drive.File{Id: "mock-file-id", Name: f.Name, MimeType: f.MimeType, Parents: f.Parents}, nil
 }
 
+func (m *MockDriveAPI) CopyFile(ctx context.Context, fileID string, f *drive.File, supportsAllDrives bool) (*drive.File, error) {
+	if m.CopyFileFunc != nil {
+		return m.CopyFileFunc(ctx, fileID, f, supportsAllDrives)
+	}
+	return &drive.File{Id: "mock-copy-id", Name: f.Name, Parents: f.Parents}, nil
+}
+
+func (m *MockDriveAPI) UploadFile(ctx context.Context, f *drive.File, contentType string, content []byte, supportsAllDrives bool) (*drive.File, error) {
+	if m.UploadFileFunc != nil {
+		return m.UploadFileFunc(ctx, f, contentType, content, supportsAllDrives)
+	}
+	return &drive.File{Id: "mock-upload-id", Name: f.Name, MimeType: f.MimeType, Parents: f.Parents}, nil
+}
+
 func (m *MockDriveAPI) UpdateFile(ctx context.Context, fileID string, f *drive.File, addParents string, removeParents string, supportsAllDrives bool) (*drive.File, error) {
 	if m.UpdateFileFunc != nil {
 		return m.UpdateFileFunc(ctx, fileID, f, addParents, removeParents, supportsAllDrives)
@@ -124,3 +151,17 @@ func (m *MockDriveAPI) ListFiles(ctx context.Context, q string, supportsAllDrive
 	}
 	return []*drive.File{}, nil
 }
+
+func (m *MockDriveAPI) GetAppProperties(ctx context.Context, fileID string, supportsAllDrives bool) (map[string]string, error) {
+	if m.GetAppPropertiesFunc != nil {
+		return m.GetAppPropertiesFunc(ctx, fileID, supportsAllDrives)
+	}
+	return map[string]string{}, nil
+}
+
+func (m *MockDriveAPI) UpdateAppProperties(ctx context.Context, fileID string, set map[string]string, remove []string, supportsAllDrives bool) error {
+	if m.UpdateAppPropertiesFunc != nil {
+		return m.UpdateAppPropertiesFunc(ctx, fileID, set, remove, supportsAllDrives)
+	}
+	return nil
+}
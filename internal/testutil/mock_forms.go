@@ -17,6 +17,7 @@ type MockFormsAPI struct {
 	GetFunc                func(ctx context.Context, formID string) (*forms.Form, error)
 	BatchUpdateFunc        func(ctx context.Context, formID string, req *forms.BatchUpdateFormRequest) (*forms.BatchUpdateFormResponse, error)
 	SetPublishSettingsFunc func(ctx context.Context, formID string, isPublished bool, isAccepting bool) error
+	ListResponsesFunc      func(ctx context.Context, formID string) ([]*forms.FormResponse, error)
 }
 
 var _ client.FormsAPI = &MockFormsAPI{}
@@ -57,3 +58,10 @@ func (m *MockFormsAPI) SetPublishSettings(
 	}
 	return nil
 }
+
+func (m *MockFormsAPI) ListResponses(ctx context.Context, formID string) ([]*forms.FormResponse, error) {
+	if m.ListResponsesFunc != nil {
+		return m.ListResponsesFunc(ctx, formID)
+	}
+	return nil, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Command formdiff prints a structural, item-level diff between two Google
+// Forms, for example the staging and production copies of a form.
+//
+// Usage:
+//
+//	go run ./tools/formdiff [-json] FROM TO
+//
+// FROM and TO are form IDs, or paths to saved Forms API JSON responses when
+// they end in ".json". The exit status is 0 when the forms match, 1 when they
+// differ and 2 on errors, so the command can gate CI pipelines.
+//
+// Credentials come from -credentials (a JSON key or a path to one), then the
+// GOOGLE_CREDENTIALS environment variable, then Application Default
+// Credentials.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+	"github.com/45ck/terraform-provider-googleforms/internal/convert"
+	"github.com/45ck/terraform-provider-googleforms/tools/internal/formsource"
+)
+
+func main() {
+	asJSON := flag.Bool("json", false, "print the diff as JSON")
+	credentials := flag.String("credentials", "", "service account JSON, or a path to it")
+	impersonate := flag.String("impersonate-user", "", "user to impersonate with domain-wide delegation")
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: formdiff [-json] FROM TO")
+		os.Exit(2)
+	}
+
+	diff, err := run(flag.Arg(0), flag.Arg(1), formsource.Credentials{JSON: *credentials, ImpersonateUser: *impersonate})
+	if err == nil {
+		if *asJSON {
+			err = writeJSON(os.Stdout, diff)
+		} else {
+			err = writeText(os.Stdout, diff)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "formdiff:", err)
+		os.Exit(2)
+	}
+	if !diff.Empty() {
+		os.Exit(1)
+	}
+}
+
+func run(fromArg, toArg string, creds formsource.Credentials) (convert.FormDiff, error) {
+	var api *client.Client
+	load := func(arg string) (*convert.FormModel, error) {
+		var form *forms.Form
+		var err error
+		if strings.HasSuffix(arg, ".json") {
+			form, err = formsource.ReadFile(arg)
+		} else {
+			if api == nil {
+				if api, err = formsource.NewClient(context.Background(), creds); err != nil {
+					return nil, err
+				}
+			}
+			form, err = api.Forms.Get(context.Background(), arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return convert.FormToModel(form, nil)
+	}
+
+	from, err := load(fromArg)
+	if err != nil {
+		return convert.FormDiff{}, err
+	}
+	to, err := load(toArg)
+	if err != nil {
+		return convert.FormDiff{}, err
+	}
+	return convert.DiffForms(from, to), nil
+}
+
+func writeJSON(w io.Writer, diff convert.FormDiff) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(diff)
+}
+
+func writeText(w io.Writer, diff convert.FormDiff) error {
+	var b strings.Builder
+	if diff.Empty() {
+		b.WriteString("Forms match.\n")
+	}
+	for _, c := range diff.Form {
+		fmt.Fprintf(&b, "~ form %s: %s -> %s\n", c.Field, c.From, c.To)
+	}
+	for _, c := range diff.Items {
+		switch c.Change {
+		case convert.ItemAdded:
+			fmt.Fprintf(&b, "+ %s %q at %d\n", c.Type, c.Title, *c.ToIndex)
+		case convert.ItemRemoved:
+			fmt.Fprintf(&b, "- %s %q at %d\n", c.Type, c.Title, *c.FromIndex)
+		case convert.ItemMoved:
+			fmt.Fprintf(&b, "> %s %q moved %d -> %d\n", c.Type, c.Title, *c.FromIndex, *c.ToIndex)
+		case convert.ItemChanged:
+			fmt.Fprintf(&b, "~ %s %q at %d", c.Type, c.Title, *c.ToIndex)
+			if c.Moved {
+				fmt.Fprintf(&b, " (moved from %d)", *c.FromIndex)
+			}
+			b.WriteString("\n")
+			for _, f := range c.Fields {
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", f.Field, f.From, f.To)
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Command formgen prints a googleforms_form resource, with an import block,
+// for an existing Google Form.
+//
+// Usage:
+//
+//	go run ./tools/formgen -form-id FORM_ID [-name NAME] [-keys slug] [-out FILE]
+//	go run ./tools/formgen -form-json form.json
+//
+// Credentials come from -credentials (a JSON key or a path to one), then the
+// GOOGLE_CREDENTIALS environment variable, then Application Default
+// Credentials.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/hclgen"
+	"github.com/45ck/terraform-provider-googleforms/tools/internal/formsource"
+)
+
+var resourceNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+func main() {
+	formID := flag.String("form-id", "", "ID of the form to generate configuration for")
+	formJSON := flag.String("form-json", "", "read the form from a Forms API JSON file instead of the API")
+	name := flag.String("name", "form", "Terraform resource name")
+	keys := flag.String("keys", "position", "item_key style: position (item_0, item_1, ...) or slug (from question titles)")
+	credentials := flag.String("credentials", "", "service account JSON, or a path to it")
+	impersonate := flag.String("impersonate-user", "", "user to impersonate with domain-wide delegation")
+	out := flag.String("out", "", "write to this file instead of stdout")
+	flag.Parse()
+
+	if err := run(*formID, *formJSON, *name, *keys, *credentials, *impersonate, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "formgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(formID, formJSON, name, keys, credentials, impersonate, out string) error {
+	if !resourceNameRe.MatchString(name) {
+		return fmt.Errorf("invalid resource name %q", name)
+	}
+	if keys != "position" && keys != "slug" {
+		return fmt.Errorf("-keys must be position or slug")
+	}
+
+	form, err := loadForm(formID, formJSON, credentials, impersonate)
+	if err != nil {
+		return err
+	}
+
+	hcl, err := hclgen.Generate(form, hclgen.Options{ResourceName: name, SlugKeys: keys == "slug"})
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = fmt.Print(hcl)
+		return err
+	}
+	return os.WriteFile(out, []byte(hcl), 0o644)
+}
+
+func loadForm(formID, formJSON, credentials, impersonate string) (*forms.Form, error) {
+	if formJSON != "" {
+		return formsource.ReadFile(formJSON)
+	}
+	if formID == "" {
+		return nil, fmt.Errorf("one of -form-id or -form-json is required")
+	}
+
+	ctx := context.Background()
+	c, err := formsource.NewClient(ctx, formsource.Credentials{JSON: credentials, ImpersonateUser: impersonate})
+	if err != nil {
+		return nil, err
+	}
+	return c.Forms.Get(ctx, formID)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 terraform-provider-googleforms contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package formsource loads forms for the command-line tools, either from the
+// Forms API or from a saved Forms API JSON response.
+package formsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	forms "google.golang.org/api/forms/v1"
+
+	"github.com/45ck/terraform-provider-googleforms/internal/client"
+)
+
+// Credentials configure API access. JSON is a service account key or a path to
+// one; when empty, GOOGLE_CREDENTIALS and then Application Default Credentials
+// are used.
+type Credentials struct {
+	JSON            string
+	ImpersonateUser string
+}
+
+// NewClient returns an API client for creds.
+func NewClient(ctx context.Context, creds Credentials) (*client.Client, error) {
+	credentials := creds.JSON
+	if credentials == "" {
+		credentials = os.Getenv("GOOGLE_CREDENTIALS")
+	}
+	if c := strings.TrimSpace(credentials); c != "" && !strings.HasPrefix(c, "{") {
+		data, err := os.ReadFile(c)
+		if err != nil {
+			return nil, fmt.Errorf("reading credentials: %w", err)
+		}
+		credentials = string(data)
+	}
+	return client.NewClient(ctx, credentials, creds.ImpersonateUser, client.Options{})
+}
+
+// ReadFile reads a form from a Forms API JSON file.
+func ReadFile(path string) (*forms.Form, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var form forms.Form
+	if err := json.Unmarshal(data, &form); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &form, nil
+}